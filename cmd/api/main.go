@@ -14,9 +14,11 @@ import (
 
 	"events-api/internal/config"
 	"events-api/internal/handlers"
+	"events-api/internal/jobs"
 	"events-api/internal/middleware"
 	"events-api/internal/repositories"
 	"events-api/internal/services"
+	"events-api/internal/storage"
 	"events-api/pkg/database"
 
 	_ "events-api/docs" // Importa la documentación generada
@@ -37,12 +39,37 @@ func main() {
 			config.NewConfig,
 			database.NewMongoClient,
 			repositories.NewEventRepository,
+			repositories.NewEventTypeRepository,
+			repositories.NewRuleRepository,
+			repositories.NewHistoryRepository,
+			repositories.NewIdempotencyRepository,
+			repositories.NewTeamRepository,
+			repositories.NewSLAPolicyRepository,
+			repositories.NewEscalationPolicyRepository,
+			repositories.NewCommentRepository,
+			storage.NewBlobStore,
+			services.NewEventTypeService,
+			services.NewRuleService,
+			services.NewTeamService,
+			services.NewSLAService,
+			services.NewEscalationService,
+			services.NewCommentService,
 			services.NewEventService,
 			handlers.NewEventHandler,
+			handlers.NewEventTypeHandler,
+			handlers.NewRuleHandler,
+			handlers.NewTeamHandler,
+			handlers.NewSLAHandler,
+			handlers.NewEscalationHandler,
+			handlers.NewCommentHandler,
+			handlers.NewAttachmentHandler,
+			jobs.NewTrashPurger,
+			jobs.NewSLAChecker,
+			jobs.NewEscalationScheduler,
 			newGinRouter,
 		),
 		// Registra los hooks del ciclo de vida
-		fx.Invoke(registerHooks),
+		fx.Invoke(registerHooks, registerJobs),
 	)
 
 	// Inicia la aplicación
@@ -71,6 +98,7 @@ func main() {
 func newGinRouter() *gin.Engine {
 	r := gin.Default()
 	r.Use(middleware.Logger())
+	r.Use(middleware.Actor())
 
 	// Rutas Swagger
 	r.GET("/swagger/*any", ginSwagger.WrapHandler(swaggerFiles.Handler))
@@ -83,30 +111,117 @@ func registerHooks(
 	lc fx.Lifecycle,
 	router *gin.Engine,
 	eventHandler *handlers.EventHandler,
+	eventTypeHandler *handlers.EventTypeHandler,
+	ruleHandler *handlers.RuleHandler,
+	teamHandler *handlers.TeamHandler,
+	slaHandler *handlers.SLAHandler,
+	escalationHandler *handlers.EscalationHandler,
+	commentHandler *handlers.CommentHandler,
+	attachmentHandler *handlers.AttachmentHandler,
+	idempotencyRepository repositories.IdempotencyRepository,
 	mongoClient *mongo.Client,
 	cfg *config.Config,
 ) {
 	lc.Append(fx.Hook{
 		OnStart: func(context.Context) error {
 			// Configuración de rutas
+			// Las solicitudes POST con Idempotency-Key no se procesan dos veces
+			idempotent := middleware.Idempotency(idempotencyRepository)
+
 			v1 := router.Group("/api/v1")
 			{
 				events := v1.Group("/events")
 				{
-					events.POST("", eventHandler.CreateEvent)
+					events.POST("", idempotent, eventHandler.CreateEvent)
+					events.POST("/batch", idempotent, eventHandler.CreateEvents)
 					events.GET("", eventHandler.GetAllEvents)
+					events.GET("/search", eventHandler.SearchEvents)
+					events.GET("/stats", eventHandler.GetEventStats)
+					events.GET("/queue", eventHandler.GetTriageQueue)
+					events.GET("/overdue", eventHandler.GetOverdueEvents)
 					events.GET("/:id", eventHandler.GetEventByID)
+					events.GET("/:id/history", eventHandler.GetEventHistory)
+					events.GET("/:id/comments", commentHandler.GetComments)
+					events.POST("/:id/comments", commentHandler.CreateComment)
+					events.GET("/:id/comments/:commentId", commentHandler.GetComment)
+					events.PUT("/:id/comments/:commentId", commentHandler.UpdateComment)
+					events.DELETE("/:id/comments/:commentId", commentHandler.DeleteComment)
+					events.GET("/:id/attachments", attachmentHandler.GetAttachments)
+					events.POST("/:id/attachments", attachmentHandler.UploadAttachment)
+					events.GET("/:id/attachments/:attachmentId", attachmentHandler.DownloadAttachment)
+					events.DELETE("/:id/attachments/:attachmentId", attachmentHandler.DeleteAttachment)
+					events.POST("/:id/restore", eventHandler.RestoreEvent)
+					events.GET("/trash", eventHandler.GetDeletedEvents)
 					events.PUT("/:id", eventHandler.UpdateEvent)
+					events.PATCH("/:id", eventHandler.PatchEvent)
 					events.DELETE("/:id", eventHandler.DeleteEvent)
 					events.PUT("/:id/review", eventHandler.ReviewEvent)
 					events.PUT("/:id/unreview", eventHandler.UnreviewEvent)
+					events.PUT("/:id/assign", eventHandler.AssignEvent)
+					events.PUT("/:id/unassign", eventHandler.UnassignEvent)
+					events.POST("/:id/tags", eventHandler.AddEventTags)
+					events.DELETE("/:id/tags/:tag", eventHandler.RemoveEventTag)
+					events.PUT("/:id/start", eventHandler.StartEvent)
+					events.PUT("/:id/resolve", eventHandler.ResolveEvent)
+					events.PUT("/:id/close", eventHandler.CloseEvent)
+					events.PUT("/:id/reopen", eventHandler.ReopenEvent)
+					events.PUT("/:id/acknowledge", eventHandler.AcknowledgeEscalation)
+					events.GET("/mine", eventHandler.GetMyEvents)
+					events.GET("/unassigned", eventHandler.GetUnassignedEvents)
+					events.POST("/bulk", idempotent, eventHandler.BulkEvents)
 					events.GET("/types", eventHandler.GetEventTypes)
-					events.POST("/seed", eventHandler.SeedEvents)
+					events.GET("/tags", eventHandler.GetEventTags)
+					events.POST("/seed", idempotent, eventHandler.SeedEvents)
 					events.GET("/status", eventHandler.GetEventStatus)
+					events.GET("/priorities", eventHandler.GetEventPriorities)
 					events.GET("/management-status", eventHandler.GetEventManagementStatus)
 					events.GET("/management-required", eventHandler.GetEventsRequiringManagement)
 					events.GET("/no-management-required", eventHandler.GetEventsNotRequiringManagement)
 				}
+
+				eventTypes := v1.Group("/event-types")
+				{
+					eventTypes.POST("", eventTypeHandler.CreateEventType)
+					eventTypes.GET("", eventTypeHandler.GetEventTypes)
+					eventTypes.GET("/:code", eventTypeHandler.GetEventType)
+					eventTypes.PUT("/:code", eventTypeHandler.UpdateEventType)
+					eventTypes.DELETE("/:code", eventTypeHandler.DeleteEventType)
+				}
+
+				rules := v1.Group("/rules")
+				{
+					rules.POST("", ruleHandler.CreateRule)
+					rules.GET("", ruleHandler.GetAllRules)
+					rules.GET("/:id", ruleHandler.GetRuleByID)
+					rules.PUT("/:id", ruleHandler.UpdateRule)
+					rules.DELETE("/:id", ruleHandler.DeleteRule)
+				}
+
+				teams := v1.Group("/teams")
+				{
+					teams.POST("", teamHandler.CreateTeam)
+					teams.GET("", teamHandler.GetAllTeams)
+					teams.GET("/:id", teamHandler.GetTeamByID)
+					teams.PUT("/:id", teamHandler.UpdateTeam)
+					teams.DELETE("/:id", teamHandler.DeleteTeam)
+				}
+
+				slaPolicies := v1.Group("/sla-policies")
+				{
+					slaPolicies.GET("", slaHandler.GetAllPolicies)
+					slaPolicies.GET("/:type", slaHandler.GetPolicy)
+					slaPolicies.PUT("/:type", slaHandler.UpdatePolicy)
+					slaPolicies.DELETE("/:type", slaHandler.DeletePolicy)
+				}
+
+				escalationPolicies := v1.Group("/escalation-policies")
+				{
+					escalationPolicies.POST("", escalationHandler.CreatePolicy)
+					escalationPolicies.GET("", escalationHandler.GetAllPolicies)
+					escalationPolicies.GET("/:id", escalationHandler.GetPolicyByID)
+					escalationPolicies.PUT("/:id", escalationHandler.UpdatePolicy)
+					escalationPolicies.DELETE("/:id", escalationHandler.DeletePolicy)
+				}
 			}
 
 			// Inicia el servidor HTTP
@@ -124,3 +239,24 @@ func registerHooks(
 		},
 	})
 }
+
+// Registra las tareas en segundo plano en el ciclo de vida de la aplicación
+func registerJobs(lc fx.Lifecycle, trashPurger *jobs.TrashPurger, slaChecker *jobs.SLAChecker, escalationScheduler *jobs.EscalationScheduler) {
+	lc.Append(fx.Hook{
+		OnStart: func(context.Context) error {
+			trashPurger.Start()
+			slaChecker.Start()
+			escalationScheduler.Start()
+			return nil
+		},
+		OnStop: func(ctx context.Context) error {
+			if err := escalationScheduler.Stop(ctx); err != nil {
+				return err
+			}
+			if err := slaChecker.Stop(ctx); err != nil {
+				return err
+			}
+			return trashPurger.Stop(ctx)
+		},
+	})
+}
@@ -15,32 +15,26 @@ const docTemplate = `{
     "host": "{{.Host}}",
     "basePath": "{{.BasePath}}",
     "paths": {
-        "/events": {
+        "/escalation-policies": {
             "get": {
-                "description": "Obtiene una lista de todos los eventos",
+                "description": "Obtiene todas las políticas de escalado por tipo de evento",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "events"
+                    "escalation"
                 ],
-                "summary": "Obtener todos los eventos",
+                "summary": "Obtener las políticas de escalado",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
                             "type": "array",
                             "items": {
-                                "$ref": "#/definitions/models.EventResponse"
+                                "$ref": "#/definitions/models.EscalationPolicy"
                             }
                         }
                     },
-                    "404": {
-                        "description": "No se encontraron eventos",
-                        "schema": {
-                            "$ref": "#/definitions/models.ErrorResponse"
-                        }
-                    },
                     "500": {
                         "description": "Error interno del servidor",
                         "schema": {
@@ -50,7 +44,7 @@ const docTemplate = `{
                 }
             },
             "post": {
-                "description": "Crea un nuevo evento con la información proporcionada",
+                "description": "Crea la cadena de escalado de un tipo de evento. Cada nivel se alcanza cuando el evento lleva sin atender el retraso indicado desde su creación o su reapertura; los retrasos deben ser crecientes.",
                 "consumes": [
                     "application/json"
                 ],
@@ -58,17 +52,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "events"
+                    "escalation"
                 ],
-                "summary": "Crear un nuevo evento",
+                "summary": "Crear una política de escalado",
                 "parameters": [
                     {
-                        "description": "Información del evento",
-                        "name": "event",
+                        "description": "Información de la política",
+                        "name": "policy",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/models.CreateEventRequest"
+                            "$ref": "#/definitions/models.CreateEscalationPolicyRequest"
                         }
                     }
                 ],
@@ -76,7 +70,7 @@ const docTemplate = `{
                     "201": {
                         "description": "Created",
                         "schema": {
-                            "$ref": "#/definitions/models.EventResponse"
+                            "$ref": "#/definitions/models.EscalationPolicy"
                         }
                     },
                     "400": {
@@ -85,6 +79,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/models.ErrorResponse"
                         }
                     },
+                    "409": {
+                        "description": "Ya existe una política para el tipo de evento",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
                     "500": {
                         "description": "Error interno del servidor",
                         "schema": {
@@ -94,28 +94,34 @@ const docTemplate = `{
                 }
             }
         },
-        "/events/management-required": {
+        "/escalation-policies/{id}": {
             "get": {
-                "description": "Obtiene una lista de eventos revisados que requieren gestión",
+                "description": "Obtiene una política de escalado por su ID",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "events"
+                    "escalation"
+                ],
+                "summary": "Obtener una política de escalado por ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID de la política",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
                 ],
-                "summary": "Obtener eventos que requieren gestión",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/models.EventResponse"
-                            }
+                            "$ref": "#/definitions/models.EscalationPolicy"
                         }
                     },
                     "404": {
-                        "description": "No se encontraron eventos",
+                        "description": "Política no encontrada",
                         "schema": {
                             "$ref": "#/definitions/models.ErrorResponse"
                         }
@@ -127,53 +133,58 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/events/management-status": {
-            "get": {
-                "description": "Obtiene una lista de los estados de gestión de eventos disponibles",
+            },
+            "put": {
+                "description": "Actualiza los campos proporcionados de una política de escalado",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "events"
+                    "escalation"
                 ],
-                "summary": "Obtener estados de gestión de eventos",
-                "responses": {
-                    "200": {
-                        "description": "OK",
+                "summary": "Actualizar una política de escalado",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID de la política",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Campos a actualizar",
+                        "name": "policy",
+                        "in": "body",
+                        "required": true,
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/models.UpdateEscalationPolicyRequest"
                         }
                     }
-                }
-            }
-        },
-        "/events/no-management-required": {
-            "get": {
-                "description": "Obtiene una lista de eventos revisados que no requieren gestión",
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "events"
                 ],
-                "summary": "Obtener eventos que no requieren gestión",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/models.EventResponse"
-                            }
+                            "$ref": "#/definitions/models.EscalationPolicy"
+                        }
+                    },
+                    "400": {
+                        "description": "Error en los datos de entrada",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
                         }
                     },
                     "404": {
-                        "description": "No se encontraron eventos",
+                        "description": "Política no encontrada",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Ya existe una política para el tipo de evento",
                         "schema": {
                             "$ref": "#/definitions/models.ErrorResponse"
                         }
@@ -185,23 +196,30 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/events/seed": {
-            "post": {
-                "description": "Genera eventos de ejemplo para pruebas",
-                "produces": [
-                    "application/json"
-                ],
+            },
+            "delete": {
+                "description": "Elimina una política de escalado; los eventos ya escalados conservan su nivel",
                 "tags": [
-                    "events"
+                    "escalation"
+                ],
+                "summary": "Eliminar una política de escalado",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID de la política",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
                 ],
-                "summary": "Generar eventos de ejemplo",
                 "responses": {
-                    "201": {
-                        "description": "Eventos generados correctamente",
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "404": {
+                        "description": "Política no encontrada",
                         "schema": {
-                            "$ref": "#/definitions/models.SuccessResponse"
+                            "$ref": "#/definitions/models.ErrorResponse"
                         }
                     },
                     "500": {
@@ -213,67 +231,100 @@ const docTemplate = `{
                 }
             }
         },
-        "/events/status": {
+        "/event-types": {
             "get": {
-                "description": "Obtiene una lista de los estados de eventos disponibles",
+                "description": "Obtiene todos los tipos de evento registrados, activos e inactivos, ordenados por código",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "events"
+                    "event-types"
                 ],
-                "summary": "Obtener estados de eventos",
+                "summary": "Obtener los tipos de evento",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
                             "type": "array",
                             "items": {
-                                "type": "string"
+                                "$ref": "#/definitions/models.EventTypeDefinition"
                             }
                         }
+                    },
+                    "500": {
+                        "description": "Error interno del servidor",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
                     }
                 }
-            }
-        },
-        "/events/types": {
-            "get": {
-                "description": "Obtiene una lista de los tipos de eventos disponibles",
+            },
+            "post": {
+                "description": "Registra un tipo de evento con su nombre, color y valores predeterminados. El código se normaliza a mayúsculas y no se puede cambiar. Si se indica attributesSchema, los campos personalizados de los eventos del tipo deben cumplir ese esquema JSON (draft 2020-12 salvo que indique $schema; no admite referencias externas).",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "events"
+                    "event-types"
+                ],
+                "summary": "Registrar un tipo de evento",
+                "parameters": [
+                    {
+                        "description": "Información del tipo de evento",
+                        "name": "eventType",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.CreateEventTypeRequest"
+                        }
+                    }
                 ],
-                "summary": "Obtener tipos de eventos",
                 "responses": {
-                    "200": {
-                        "description": "OK",
+                    "201": {
+                        "description": "Created",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/models.EventTypeDefinition"
+                        }
+                    },
+                    "400": {
+                        "description": "Error en los datos de entrada",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Ya existe un tipo de evento con el código indicado",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Error interno del servidor",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
                         }
                     }
                 }
             }
         },
-        "/events/{id}": {
+        "/event-types/{code}": {
             "get": {
-                "description": "Obtiene un evento por su ID",
+                "description": "Obtiene un tipo de evento por su código",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "events"
+                    "event-types"
                 ],
-                "summary": "Obtener un evento por ID",
+                "summary": "Obtener un tipo de evento",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "ID del evento",
-                        "name": "id",
+                        "description": "Código del tipo de evento",
+                        "name": "code",
                         "in": "path",
                         "required": true
                     }
@@ -282,11 +333,11 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.EventResponse"
+                            "$ref": "#/definitions/models.EventTypeDefinition"
                         }
                     },
                     "404": {
-                        "description": "Evento no encontrado",
+                        "description": "Tipo de evento no encontrado",
                         "schema": {
                             "$ref": "#/definitions/models.ErrorResponse"
                         }
@@ -300,7 +351,7 @@ const docTemplate = `{
                 }
             },
             "put": {
-                "description": "Actualiza un evento existente",
+                "description": "Actualiza los campos proporcionados de un tipo de evento. Desactivar un tipo impide crear eventos nuevos de ese tipo; los existentes lo conservan. Un attributesSchema null elimina el esquema; los eventos existentes no se vuelven a validar hasta que se modifiquen sus campos personalizados o su tipo.",
                 "consumes": [
                     "application/json"
                 ],
@@ -308,24 +359,24 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "events"
+                    "event-types"
                 ],
-                "summary": "Actualizar un evento",
+                "summary": "Actualizar un tipo de evento",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "ID del evento",
-                        "name": "id",
+                        "description": "Código del tipo de evento",
+                        "name": "code",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "Información del evento",
-                        "name": "event",
+                        "description": "Campos a actualizar",
+                        "name": "eventType",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/models.UpdateEventRequest"
+                            "$ref": "#/definitions/models.UpdateEventTypeRequest"
                         }
                     }
                 ],
@@ -333,7 +384,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.EventResponse"
+                            "$ref": "#/definitions/models.EventTypeDefinition"
                         }
                     },
                     "400": {
@@ -343,7 +394,7 @@ const docTemplate = `{
                         }
                     },
                     "404": {
-                        "description": "Evento no encontrado",
+                        "description": "Tipo de evento no encontrado",
                         "schema": {
                             "$ref": "#/definitions/models.ErrorResponse"
                         }
@@ -357,16 +408,16 @@ const docTemplate = `{
                 }
             },
             "delete": {
-                "description": "Elimina un evento existente",
+                "description": "Elimina un tipo de evento que no usa ningún evento, incluidos los de la papelera, ni ninguna regla, equipo o política. Los tipos en uso deben desactivarse.",
                 "tags": [
-                    "events"
+                    "event-types"
                 ],
-                "summary": "Eliminar un evento",
+                "summary": "Eliminar un tipo de evento",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "ID del evento",
-                        "name": "id",
+                        "description": "Código del tipo de evento",
+                        "name": "code",
                         "in": "path",
                         "required": true
                     }
@@ -376,7 +427,13 @@ const docTemplate = `{
                         "description": "No Content"
                     },
                     "404": {
-                        "description": "Evento no encontrado",
+                        "description": "Tipo de evento no encontrado",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "El tipo de evento está en uso",
                         "schema": {
                             "$ref": "#/definitions/models.ErrorResponse"
                         }
@@ -390,34 +447,170 @@ const docTemplate = `{
                 }
             }
         },
-        "/events/{id}/review": {
-            "put": {
-                "description": "Marca un evento como revisado y asigna automáticamente un estado de gestión según su tipo",
+        "/events": {
+            "get": {
+                "description": "Obtiene una lista paginada de eventos. Los filtros admiten varios valores, repitiendo el parámetro o separándolos por comas.\nCon el orden por defecto la respuesta incluye nextCursor, que puede enviarse en el parámetro cursor para paginar de forma estable aunque se inserten eventos nuevos",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
                     "events"
                 ],
-                "summary": "Revisar un evento",
+                "summary": "Obtener todos los eventos",
                 "parameters": [
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "Número de página (desde 1)",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 20,
+                        "description": "Tamaño de página (máximo 100)",
+                        "name": "pageSize",
+                        "in": "query"
+                    },
                     {
                         "type": "string",
-                        "description": "ID del evento",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "description": "Cursor opaco devuelto en nextCursor; no se combina con page ni sort",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "-date,name",
+                        "description": "Campos de ordenación separados por comas; prefijo - para orden descendente (date, name, type, status, priority, severity, createdAt, updatedAt)",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "multi",
+                        "description": "Tipos de evento",
+                        "name": "type",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "multi",
+                        "description": "Estados del evento",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "multi",
+                        "description": "Estados de gestión",
+                        "name": "managementStatus",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Fecha del evento desde (RFC3339 o YYYY-MM-DD)",
+                        "name": "dateFrom",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Fecha del evento hasta (RFC3339 o YYYY-MM-DD)",
+                        "name": "dateTo",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Fecha de creación desde (RFC3339 o YYYY-MM-DD)",
+                        "name": "createdFrom",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Fecha de creación hasta (RFC3339 o YYYY-MM-DD)",
+                        "name": "createdTo",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "multi",
+                        "description": "Usuarios responsables",
+                        "name": "assigneeId",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "multi",
+                        "description": "Equipos responsables",
+                        "name": "teamId",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Solo eventos sin usuario responsable",
+                        "name": "unassigned",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "multi",
+                        "description": "Etiquetas; el evento debe tener todas",
+                        "name": "tag",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "multi",
+                        "description": "Valores de un atributo, con un parámetro label.\u003cclave\u003e por atributo (por ejemplo label.region=eu)",
+                        "name": "label.region",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "multi",
+                        "description": "Valores de un campo personalizado, con un parámetro attr.\u003cruta\u003e por campo (por ejemplo attr.source=nagios o attr.window.start)",
+                        "name": "attr.source",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.EventResponse"
+                            "$ref": "#/definitions/models.EventListResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Parámetros de consulta inválidos",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
                         }
                     },
                     "404": {
-                        "description": "Evento no encontrado",
+                        "description": "No se encontraron eventos",
                         "schema": {
                             "$ref": "#/definitions/models.ErrorResponse"
                         }
@@ -429,77 +622,4972 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
+            },
+            "post": {
+                "description": "Crea un nuevo evento con la información proporcionada. Los campos personalizados (attributes) se validan con el esquema JSON del tipo de evento; los errores indican en details la ruta de cada campo no válido.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "events"
+                ],
+                "summary": "Crear un nuevo evento",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Clave para reintentar la solicitud sin crear duplicados",
+                        "name": "Idempotency-Key",
+                        "in": "header"
+                    },
+                    {
+                        "description": "Información del evento",
+                        "name": "event",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.CreateEventRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/models.EventResponse"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Versión del evento"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Error en los datos de entrada",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "La solicitud con esta clave todavía se está procesando",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "La clave de idempotencia ya se usó con otra solicitud",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Error interno del servidor",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
         },
-        "/events/{id}/unreview": {
-            "put": {
-                "description": "Devuelve un evento del estado revisado al estado pendiente",
+        "/events/batch": {
+            "post": {
+                "description": "Crea varios eventos en una sola solicitud. Cada elemento de events tiene el formato de CreateEventRequest y se valida por separado; los eventos válidos se crean aunque otros fallen. La respuesta indica, para cada posición del lote, el ID creado o el error.",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
                     "events"
                 ],
-                "summary": "Deshacer revisión de un evento",
+                "summary": "Crear un lote de eventos",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "ID del evento",
-                        "name": "id",
-                        "in": "path",
+                        "description": "Clave para reintentar la solicitud sin crear duplicados",
+                        "name": "Idempotency-Key",
+                        "in": "header"
+                    },
+                    {
+                        "description": "Lote de eventos",
+                        "name": "batch",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.BatchEventRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.BatchEventResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Lote vacío o demasiado grande",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Error interno del servidor",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/events/bulk": {
+            "post": {
+                "description": "Revisa, deshace la revisión, elimina o cambia el tipo de varios eventos en una sola solicitud. Admite una lista de operaciones o un filtro junto a una acción, y devuelve el resultado de cada operación. Con atomic=true las operaciones se aplican en una transacción: todas o ninguna.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "events"
+                ],
+                "summary": "Operación masiva sobre eventos",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Clave para reintentar la solicitud sin aplicarla dos veces",
+                        "name": "Idempotency-Key",
+                        "in": "header"
+                    },
+                    {
+                        "description": "Operaciones a aplicar",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.BulkEventRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.BulkEventResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Error en los datos de entrada",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Ningún evento cumple el filtro",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "MongoDB no admite transacciones para una operación atómica",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Error interno del servidor",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/events/management-required": {
+            "get": {
+                "description": "Obtiene una lista de eventos revisados que requieren gestión",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "events"
+                ],
+                "summary": "Obtener eventos que requieren gestión",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/models.EventResponse"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "No se encontraron eventos",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Error interno del servidor",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/events/management-status": {
+            "get": {
+                "description": "Obtiene una lista de los estados de gestión de eventos disponibles",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "events"
+                ],
+                "summary": "Obtener estados de gestión de eventos",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/events/mine": {
+            "get": {
+                "description": "Obtiene una lista paginada de los eventos asignados al usuario indicado en la cabecera X-User-ID. Admite los mismos filtros y opciones de paginación que el listado de eventos.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "events"
+                ],
+                "summary": "Obtener mis eventos",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID del usuario",
+                        "name": "X-User-ID",
+                        "in": "header",
                         "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "Número de página (desde 1)",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 20,
+                        "description": "Tamaño de página (máximo 100)",
+                        "name": "pageSize",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Campos de ordenación separados por comas; prefijo - para orden descendente",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "multi",
+                        "description": "Tipos de evento",
+                        "name": "type",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "multi",
+                        "description": "Estados del evento",
+                        "name": "status",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.EventResponse"
+                            "$ref": "#/definitions/models.EventListResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Parámetros de consulta inválidos",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Falta la cabecera X-User-ID",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "No se encontraron eventos",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Error interno del servidor",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/events/no-management-required": {
+            "get": {
+                "description": "Obtiene una lista de eventos revisados que no requieren gestión",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "events"
+                ],
+                "summary": "Obtener eventos que no requieren gestión",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/models.EventResponse"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "No se encontraron eventos",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Error interno del servidor",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/events/overdue": {
+            "get": {
+                "description": "Obtiene una lista paginada de los eventos cuyo plazo vigente ha vencido: los pendientes fuera del plazo de revisión y los que requieren gestión fuera del plazo de resolución, del más antiguo al más reciente",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "events"
+                ],
+                "summary": "Obtener los eventos con el plazo vencido",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "Número de página (desde 1)",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 20,
+                        "description": "Tamaño de página (máximo 100)",
+                        "name": "pageSize",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.EventListResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Parámetros de consulta inválidos",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "No hay eventos con el plazo vencido",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Error interno del servidor",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/events/priorities": {
+            "get": {
+                "description": "Obtiene una lista de las prioridades de eventos disponibles, de la más urgente a la menos urgente",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "events"
+                ],
+                "summary": "Obtener prioridades de eventos",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/events/queue": {
+            "get": {
+                "description": "Obtiene una lista paginada de los eventos pendientes ordenados por prioridad (de P1 a P4) y, dentro de la misma prioridad, del más antiguo al más reciente",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "events"
+                ],
+                "summary": "Obtener la cola de triaje",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "Número de página (desde 1)",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 20,
+                        "description": "Tamaño de página (máximo 100)",
+                        "name": "pageSize",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.EventListResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Parámetros de consulta inválidos",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "No hay eventos pendientes",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Error interno del servidor",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/events/search": {
+            "get": {
+                "description": "Busca eventos por texto en su nombre y descripción, ordenados por relevancia. Admite los mismos filtros y la misma paginación que el listado de eventos",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "events"
+                ],
+                "summary": "Buscar eventos",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Texto a buscar",
+                        "name": "q",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "Número de página (desde 1)",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 20,
+                        "description": "Tamaño de página (máximo 100)",
+                        "name": "pageSize",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Criterios de desempate tras la relevancia (date, name, type, status, priority, severity, createdAt, updatedAt)",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "multi",
+                        "description": "Tipos de evento",
+                        "name": "type",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "multi",
+                        "description": "Estados del evento",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "multi",
+                        "description": "Estados de gestión",
+                        "name": "managementStatus",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Fecha del evento desde (RFC3339 o YYYY-MM-DD)",
+                        "name": "dateFrom",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Fecha del evento hasta (RFC3339 o YYYY-MM-DD)",
+                        "name": "dateTo",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Fecha de creación desde (RFC3339 o YYYY-MM-DD)",
+                        "name": "createdFrom",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Fecha de creación hasta (RFC3339 o YYYY-MM-DD)",
+                        "name": "createdTo",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "multi",
+                        "description": "Usuarios responsables",
+                        "name": "assigneeId",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "multi",
+                        "description": "Equipos responsables",
+                        "name": "teamId",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Solo eventos sin usuario responsable",
+                        "name": "unassigned",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "multi",
+                        "description": "Etiquetas; el evento debe tener todas",
+                        "name": "tag",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "multi",
+                        "description": "Valores de un atributo, con un parámetro label.\u003cclave\u003e por atributo (por ejemplo label.region=eu)",
+                        "name": "label.region",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "multi",
+                        "description": "Valores de un campo personalizado, con un parámetro attr.\u003cruta\u003e por campo (por ejemplo attr.source=nagios o attr.window.start)",
+                        "name": "attr.source",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.EventListResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Parámetros de consulta inválidos",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "No se encontraron eventos",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Error interno del servidor",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/events/seed": {
+            "post": {
+                "description": "Genera eventos de ejemplo para pruebas",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "events"
+                ],
+                "summary": "Generar eventos de ejemplo",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Clave para reintentar la solicitud sin generar duplicados",
+                        "name": "Idempotency-Key",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Eventos generados correctamente",
+                        "schema": {
+                            "$ref": "#/definitions/models.SuccessResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "La solicitud con esta clave todavía se está procesando",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "La clave de idempotencia ya se usó con otra solicitud",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Error interno del servidor",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/events/stats": {
+            "get": {
+                "description": "Obtiene el número de eventos por tipo, estado y estado de gestión, y opcionalmente un histograma por día, semana o mes.\nAdmite los mismos filtros que el listado de eventos. Los eventos sin estado de gestión se agrupan como NONE",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "events"
+                ],
+                "summary": "Obtener estadísticas de eventos",
+                "parameters": [
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "multi",
+                        "description": "Dimensiones a agrupar (type, status, managementStatus); por defecto todas",
+                        "name": "groupBy",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Intervalo del histograma (day, week, month)",
+                        "name": "interval",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "default": "createdAt",
+                        "description": "Campo de fecha del histograma (date, createdAt)",
+                        "name": "dateField",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "multi",
+                        "description": "Tipos de evento",
+                        "name": "type",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "multi",
+                        "description": "Estados del evento",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "multi",
+                        "description": "Estados de gestión",
+                        "name": "managementStatus",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Fecha del evento desde (RFC3339 o YYYY-MM-DD)",
+                        "name": "dateFrom",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Fecha del evento hasta (RFC3339 o YYYY-MM-DD)",
+                        "name": "dateTo",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Fecha de creación desde (RFC3339 o YYYY-MM-DD)",
+                        "name": "createdFrom",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Fecha de creación hasta (RFC3339 o YYYY-MM-DD)",
+                        "name": "createdTo",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "multi",
+                        "description": "Usuarios responsables",
+                        "name": "assigneeId",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "multi",
+                        "description": "Equipos responsables",
+                        "name": "teamId",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Solo eventos sin usuario responsable",
+                        "name": "unassigned",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "multi",
+                        "description": "Etiquetas; el evento debe tener todas",
+                        "name": "tag",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "multi",
+                        "description": "Valores de un atributo, con un parámetro label.\u003cclave\u003e por atributo (por ejemplo label.region=eu)",
+                        "name": "label.region",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "multi",
+                        "description": "Valores de un campo personalizado, con un parámetro attr.\u003cruta\u003e por campo (por ejemplo attr.source=nagios o attr.window.start)",
+                        "name": "attr.source",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.EventStats"
+                        }
+                    },
+                    "400": {
+                        "description": "Parámetros de consulta inválidos",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Error interno del servidor",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/events/status": {
+            "get": {
+                "description": "Obtiene los estados del ciclo de vida de los eventos con los estados a los que puede pasar cada uno",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "events"
+                ],
+                "summary": "Obtener estados de eventos",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/models.EventStatusInfo"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/events/tags": {
+            "get": {
+                "description": "Obtiene las etiquetas de los eventos que comienzan por el prefijo indicado, de la más usada a la menos usada, para autocompletar",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "events"
+                ],
+                "summary": "Obtener las etiquetas en uso",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Prefijo de las etiquetas",
+                        "name": "prefix",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 20,
+                        "description": "Número máximo de etiquetas (máximo 100)",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/models.TagCount"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Parámetros inválidos",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Error interno del servidor",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/events/trash": {
+            "get": {
+                "description": "Obtiene una lista paginada de los eventos eliminados, del borrado más reciente al más antiguo",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "events"
+                ],
+                "summary": "Obtener la papelera de eventos",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "Número de página (desde 1)",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 20,
+                        "description": "Tamaño de página (máximo 100)",
+                        "name": "pageSize",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.EventListResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Parámetros de consulta inválidos",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "La papelera está vacía",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Error interno del servidor",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/events/types": {
+            "get": {
+                "description": "Obtiene los códigos de los tipos de evento activos en el registro. La definición completa de cada tipo se obtiene en /event-types",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "events"
+                ],
+                "summary": "Obtener tipos de eventos",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Error interno del servidor",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/events/unassigned": {
+            "get": {
+                "description": "Obtiene una lista paginada de los eventos que requieren gestión y no tienen usuario responsable. Admite los mismos filtros y opciones de paginación que el listado de eventos.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "events"
+                ],
+                "summary": "Obtener eventos sin asignar",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "Número de página (desde 1)",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 20,
+                        "description": "Tamaño de página (máximo 100)",
+                        "name": "pageSize",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Campos de ordenación separados por comas; prefijo - para orden descendente",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "multi",
+                        "description": "Tipos de evento",
+                        "name": "type",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "multi",
+                        "description": "Equipos responsables",
+                        "name": "teamId",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.EventListResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Parámetros de consulta inválidos",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "No se encontraron eventos",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Error interno del servidor",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/events/{id}": {
+            "get": {
+                "description": "Obtiene un evento por su ID",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "events"
+                ],
+                "summary": "Obtener un evento por ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID del evento",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.EventResponse"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Versión del evento"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Evento no encontrado",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Error interno del servidor",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Actualiza un evento existente. Los campos personalizados (attributes) se validan con el esquema vigente del tipo de evento solo si cambian ellos o el tipo; los errores indican en details la ruta de cada campo no válido.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "events"
+                ],
+                "summary": "Actualizar un evento",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID del evento",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag del evento (versión esperada) o * para cualquier versión",
+                        "name": "If-Match",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "description": "Información del evento",
+                        "name": "event",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.UpdateEventRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.EventResponse"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Versión del evento"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Error en los datos de entrada",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Evento no encontrado",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "412": {
+                        "description": "El evento fue modificado por otra solicitud",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "428": {
+                        "description": "Falta la cabecera If-Match",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Error interno del servidor",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Envía un evento a la papelera. Los eventos de la papelera no aparecen en los listados y se eliminan definitivamente tras el periodo de retención",
+                "tags": [
+                    "events"
+                ],
+                "summary": "Eliminar un evento",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID del evento",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag del evento (versión esperada) o * para cualquier versión",
+                        "name": "If-Match",
+                        "in": "header",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "404": {
+                        "description": "Evento no encontrado",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "412": {
+                        "description": "El evento fue modificado por otra solicitud",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "428": {
+                        "description": "Falta la cabecera If-Match",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Error interno del servidor",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "patch": {
+                "description": "Aplica un JSON Merge Patch (RFC 7396) o un JSON Patch (RFC 6902) sobre los campos editables de un evento. El resultado se valida con las mismas reglas que la creación y solo se persisten los campos que cambian.",
+                "consumes": [
+                    "application/merge-patch+json",
+                    "application/json-patch+json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "events"
+                ],
+                "summary": "Actualizar parcialmente un evento",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID del evento",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag del evento (versión esperada) o * para cualquier versión",
+                        "name": "If-Match",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "description": "Documento de parche",
+                        "name": "patch",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "object"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.EventResponse"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Versión del evento"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Parche inválido o evento resultante no válido",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Evento no encontrado",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "412": {
+                        "description": "El evento fue modificado por otra solicitud",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "415": {
+                        "description": "Tipo de contenido no soportado",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "428": {
+                        "description": "Falta la cabecera If-Match",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Error interno del servidor",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/events/{id}/acknowledge": {
+            "put": {
+                "description": "Confirma la recepción del escalado vigente de un evento, lo que detiene su cadena de escalado hasta que el evento se reabra. Registra el usuario de la cabecera X-User-ID.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "events"
+                ],
+                "summary": "Confirmar el escalado de un evento",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID del evento",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag del evento (versión esperada) o * para cualquier versión",
+                        "name": "If-Match",
+                        "in": "header",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.EventResponse"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Versión del evento"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "El evento no tiene un escalado pendiente de confirmar",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Evento no encontrado",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "412": {
+                        "description": "El evento fue modificado por otra solicitud",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "428": {
+                        "description": "Falta la cabecera If-Match",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Error interno del servidor",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/events/{id}/assign": {
+            "put": {
+                "description": "Asigna un evento a un usuario, a un equipo o a ambos. Si solo se indica el equipo, el evento se asigna por turnos a uno de sus miembros; si se indican ambos, el usuario debe pertenecer al equipo. Si no se indica el equipo, el evento conserva el suyo; un equipo vacío lo quita.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "events"
+                ],
+                "summary": "Asignar un evento",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID del evento",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag del evento (versión esperada) o * para cualquier versión",
+                        "name": "If-Match",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "description": "Responsable del evento",
+                        "name": "assignment",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.AssignEventRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.EventResponse"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Versión del evento"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Error en los datos de entrada",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Evento o equipo no encontrado",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "412": {
+                        "description": "El evento fue modificado por otra solicitud",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "428": {
+                        "description": "Falta la cabecera If-Match",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Error interno del servidor",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/events/{id}/attachments": {
+            "get": {
+                "description": "Obtiene los metadatos de los archivos adjuntos de un evento",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "attachments"
+                ],
+                "summary": "Obtener los archivos adjuntos de un evento",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID del evento",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/models.Attachment"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "ID inválido",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Evento no encontrado",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Error interno del servidor",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Sube un archivo en el campo file de un formulario multipart/form-data y lo adjunta al evento.\nEl tamaño máximo y los tipos de contenido permitidos se configuran con MAX_ATTACHMENT_SIZE y ALLOWED_ATTACHMENT_TYPES.\nEl tipo de contenido se detecta a partir del contenido del archivo; el tipo declarado y la extensión deben coincidir con él.",
+                "consumes": [
+                    "multipart/form-data"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "attachments"
+                ],
+                "summary": "Adjuntar un archivo a un evento",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID del evento",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "file",
+                        "description": "Archivo a adjuntar",
+                        "name": "file",
+                        "in": "formData",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/models.Attachment"
+                        }
+                    },
+                    "400": {
+                        "description": "Error en los datos de entrada",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Evento no encontrado",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "413": {
+                        "description": "El archivo supera el tamaño máximo",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "415": {
+                        "description": "Tipo de archivo no permitido o que no corresponde a su contenido",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Error interno del servidor",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/events/{id}/attachments/{attachmentId}": {
+            "get": {
+                "description": "Descarga el contenido de un archivo adjunto con su tipo de contenido. Admite descargas parciales con la cabecera Range.",
+                "produces": [
+                    "application/octet-stream"
+                ],
+                "tags": [
+                    "attachments"
+                ],
+                "summary": "Descargar un archivo adjunto de un evento",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID del evento",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ID del archivo adjunto",
+                        "name": "attachmentId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Rango de bytes (por ejemplo bytes=0-1023)",
+                        "name": "Range",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "file"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Resumen SHA-256 del contenido"
+                            }
+                        }
+                    },
+                    "206": {
+                        "description": "Contenido parcial",
+                        "schema": {
+                            "type": "file"
+                        }
+                    },
+                    "400": {
+                        "description": "ID inválido",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Evento o archivo adjunto no encontrado",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "416": {
+                        "description": "Rango no satisfacible",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "Error interno del servidor",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Elimina un archivo adjunto de un evento junto a su contenido",
+                "tags": [
+                    "attachments"
+                ],
+                "summary": "Eliminar un archivo adjunto de un evento",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID del evento",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ID del archivo adjunto",
+                        "name": "attachmentId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "ID inválido",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Evento o archivo adjunto no encontrado",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Error interno del servidor",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/events/{id}/close": {
+            "put": {
+                "description": "Pasa un evento resuelto, o revisado sin necesidad de gestión, al estado CLOSED y registra la fecha de cierre",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "events"
+                ],
+                "summary": "Cerrar un evento",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID del evento",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag del evento (versión esperada) o * para cualquier versión",
+                        "name": "If-Match",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "description": "Nota de la transición",
+                        "name": "transition",
+                        "in": "body",
+                        "schema": {
+                            "$ref": "#/definitions/models.TransitionEventRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.EventResponse"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Versión del evento"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Transición de estado no permitida",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Evento no encontrado",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "412": {
+                        "description": "El evento fue modificado por otra solicitud",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "428": {
+                        "description": "Falta la cabecera If-Match",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Error interno del servidor",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/events/{id}/comments": {
+            "get": {
+                "description": "Obtiene una página de los comentarios de un evento, del más antiguo al más reciente",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "comments"
+                ],
+                "summary": "Obtener los comentarios de un evento",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID del evento",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "Número de página (desde 1)",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 20,
+                        "description": "Tamaño de página (máximo 100)",
+                        "name": "pageSize",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.CommentListResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Parámetros inválidos",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Evento no encontrado o sin comentarios",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Error interno del servidor",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Publica un comentario en Markdown en el hilo de un evento con el usuario de la cabecera X-User-ID como autor. Las menciones (@usuario) se extraen del cuerpo.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "comments"
+                ],
+                "summary": "Publicar un comentario en un evento",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID del evento",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Contenido del comentario",
+                        "name": "comment",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.CreateCommentRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/models.Comment"
+                        }
+                    },
+                    "400": {
+                        "description": "Error en los datos de entrada",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Falta la cabecera X-User-ID",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Evento no encontrado",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Error interno del servidor",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/events/{id}/comments/{commentId}": {
+            "get": {
+                "description": "Obtiene un comentario de un evento con su historial de ediciones",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "comments"
+                ],
+                "summary": "Obtener un comentario de un evento",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID del evento",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ID del comentario",
+                        "name": "commentId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.Comment"
+                        }
+                    },
+                    "404": {
+                        "description": "Evento o comentario no encontrado",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Error interno del servidor",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Sustituye el cuerpo de un comentario y conserva la versión anterior en su historial de ediciones. Solo el autor puede editar el comentario.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "comments"
+                ],
+                "summary": "Editar un comentario de un evento",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID del evento",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ID del comentario",
+                        "name": "commentId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Nuevo contenido del comentario",
+                        "name": "comment",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.UpdateCommentRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.Comment"
+                        }
+                    },
+                    "400": {
+                        "description": "Error en los datos de entrada",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Falta la cabecera X-User-ID",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "El usuario no es el autor del comentario",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Evento o comentario no encontrado",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Error interno del servidor",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Elimina un comentario. Solo el autor puede eliminar el comentario.",
+                "tags": [
+                    "comments"
+                ],
+                "summary": "Eliminar un comentario de un evento",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID del evento",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ID del comentario",
+                        "name": "commentId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "401": {
+                        "description": "Falta la cabecera X-User-ID",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "El usuario no es el autor del comentario",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Evento o comentario no encontrado",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Error interno del servidor",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/events/{id}/history": {
+            "get": {
+                "description": "Obtiene una página del historial de cambios de un evento, de la entrada más reciente a la más antigua.\nCada entrada registra el actor, la operación y los valores anteriores y posteriores de cada campo modificado",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "events"
+                ],
+                "summary": "Obtener el historial de un evento",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID del evento",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "Número de página (desde 1)",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 20,
+                        "description": "Tamaño de página (máximo 100)",
+                        "name": "pageSize",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.EventHistoryListResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Parámetros inválidos",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "No se encontró historial",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Error interno del servidor",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/events/{id}/reopen": {
+            "put": {
+                "description": "Pasa un evento resuelto o cerrado al estado REOPENED, registra la fecha de reapertura y descarta la resolución anterior, que se conserva en el historial",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "events"
+                ],
+                "summary": "Reabrir un evento",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID del evento",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag del evento (versión esperada) o * para cualquier versión",
+                        "name": "If-Match",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "description": "Nota de la transición",
+                        "name": "transition",
+                        "in": "body",
+                        "schema": {
+                            "$ref": "#/definitions/models.TransitionEventRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.EventResponse"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Versión del evento"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Transición de estado no permitida",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Evento no encontrado",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "412": {
+                        "description": "El evento fue modificado por otra solicitud",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "428": {
+                        "description": "Falta la cabecera If-Match",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Error interno del servidor",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/events/{id}/resolve": {
+            "put": {
+                "description": "Pasa un evento al estado RESOLVED y registra la nota de resolución, que es obligatoria, y la fecha de resolución",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "events"
+                ],
+                "summary": "Resolver un evento",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID del evento",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag del evento (versión esperada) o * para cualquier versión",
+                        "name": "If-Match",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "description": "Nota de la transición",
+                        "name": "transition",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.TransitionEventRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.EventResponse"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Versión del evento"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Transición de estado no permitida o falta la nota de resolución",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Evento no encontrado",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "412": {
+                        "description": "El evento fue modificado por otra solicitud",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "428": {
+                        "description": "Falta la cabecera If-Match",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Error interno del servidor",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/events/{id}/restore": {
+            "post": {
+                "description": "Saca un evento de la papelera",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "events"
+                ],
+                "summary": "Restaurar un evento",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID del evento",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.EventResponse"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Versión del evento"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "ID de evento inválido",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Evento no encontrado en la papelera",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Error interno del servidor",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/events/{id}/review": {
+            "put": {
+                "description": "Marca un evento como revisado y asigna automáticamente un estado de gestión según las reglas de gestión configuradas.\nSi ninguna regla coincide y no existe regla predeterminada, se clasifica según su tipo.\nSi el cuerpo indica managementStatus junto a una justificación, la clasificación manual sustituye a la automática\nSi el cuerpo incluye comment, se publica como comentario en el hilo del evento",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "events"
+                ],
+                "summary": "Revisar un evento",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID del evento",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag del evento (versión esperada) o * para cualquier versión",
+                        "name": "If-Match",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "description": "Clasificación manual y comentario (opcionales)",
+                        "name": "review",
+                        "in": "body",
+                        "schema": {
+                            "$ref": "#/definitions/models.ReviewEventRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.EventResponse"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Versión del evento"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Error en los datos de entrada",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Falta la cabecera X-User-ID al incluir un comentario",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Evento no encontrado",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "412": {
+                        "description": "El evento fue modificado por otra solicitud",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "428": {
+                        "description": "Falta la cabecera If-Match",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Error interno del servidor",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/events/{id}/start": {
+            "put": {
+                "description": "Pasa un evento revisado o reabierto al estado IN_PROGRESS y registra la fecha de inicio",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "events"
+                ],
+                "summary": "Iniciar la gestión de un evento",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID del evento",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag del evento (versión esperada) o * para cualquier versión",
+                        "name": "If-Match",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "description": "Nota de la transición",
+                        "name": "transition",
+                        "in": "body",
+                        "schema": {
+                            "$ref": "#/definitions/models.TransitionEventRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.EventResponse"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Versión del evento"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Transición de estado no permitida",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Evento no encontrado",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "412": {
+                        "description": "El evento fue modificado por otra solicitud",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "428": {
+                        "description": "Falta la cabecera If-Match",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Error interno del servidor",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/events/{id}/tags": {
+            "post": {
+                "description": "Añade etiquetas a un evento. Las etiquetas se normalizan a minúsculas y se ignoran las que el evento ya tiene.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "events"
+                ],
+                "summary": "Añadir etiquetas a un evento",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID del evento",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag del evento (versión esperada) o * para cualquier versión",
+                        "name": "If-Match",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "description": "Etiquetas a añadir",
+                        "name": "tags",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.TagEventRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.EventResponse"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Versión del evento"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Error en los datos de entrada",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Evento no encontrado",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "412": {
+                        "description": "El evento fue modificado por otra solicitud",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "428": {
+                        "description": "Falta la cabecera If-Match",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Error interno del servidor",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/events/{id}/tags/{tag}": {
+            "delete": {
+                "description": "Quita una etiqueta de un evento",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "events"
+                ],
+                "summary": "Quitar una etiqueta de un evento",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID del evento",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Etiqueta a quitar",
+                        "name": "tag",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag del evento (versión esperada) o * para cualquier versión",
+                        "name": "If-Match",
+                        "in": "header",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.EventResponse"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Versión del evento"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Evento no encontrado o sin la etiqueta",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "412": {
+                        "description": "El evento fue modificado por otra solicitud",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "428": {
+                        "description": "Falta la cabecera If-Match",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Error interno del servidor",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/events/{id}/unassign": {
+            "put": {
+                "description": "Elimina el usuario y el equipo responsables de un evento",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "events"
+                ],
+                "summary": "Quitar la asignación de un evento",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID del evento",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag del evento (versión esperada) o * para cualquier versión",
+                        "name": "If-Match",
+                        "in": "header",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.EventResponse"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Versión del evento"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "El evento no está asignado",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Evento no encontrado",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "412": {
+                        "description": "El evento fue modificado por otra solicitud",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "428": {
+                        "description": "Falta la cabecera If-Match",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Error interno del servidor",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/events/{id}/unreview": {
+            "put": {
+                "description": "Devuelve un evento del estado revisado al estado pendiente",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "events"
+                ],
+                "summary": "Deshacer revisión de un evento",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID del evento",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag del evento (versión esperada) o * para cualquier versión",
+                        "name": "If-Match",
+                        "in": "header",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.EventResponse"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Versión del evento"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "El evento no está en estado revisado",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Evento no encontrado",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "412": {
+                        "description": "El evento fue modificado por otra solicitud",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "428": {
+                        "description": "Falta la cabecera If-Match",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Error interno del servidor",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/rules": {
+            "get": {
+                "description": "Obtiene todas las reglas de gestión en orden de evaluación",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "rules"
+                ],
+                "summary": "Obtener las reglas de gestión",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/models.ManagementRule"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Error interno del servidor",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Crea una regla que asigna automáticamente el estado de gestión al revisar eventos",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "rules"
+                ],
+                "summary": "Crear una regla de gestión",
+                "parameters": [
+                    {
+                        "description": "Información de la regla",
+                        "name": "rule",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.CreateRuleRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/models.ManagementRule"
+                        }
+                    },
+                    "400": {
+                        "description": "Error en los datos de entrada",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Ya existe una regla predeterminada",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Error interno del servidor",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/rules/{id}": {
+            "get": {
+                "description": "Obtiene una regla de gestión por su ID",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "rules"
+                ],
+                "summary": "Obtener una regla de gestión por ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID de la regla",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.ManagementRule"
+                        }
+                    },
+                    "404": {
+                        "description": "Regla no encontrada",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Error interno del servidor",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Actualiza los campos proporcionados de una regla de gestión",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "rules"
+                ],
+                "summary": "Actualizar una regla de gestión",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID de la regla",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Campos a actualizar",
+                        "name": "rule",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.UpdateRuleRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.ManagementRule"
+                        }
+                    },
+                    "400": {
+                        "description": "Error en los datos de entrada",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Regla no encontrada",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Ya existe una regla predeterminada",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Error interno del servidor",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Elimina una regla de gestión existente",
+                "tags": [
+                    "rules"
+                ],
+                "summary": "Eliminar una regla de gestión",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID de la regla",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "404": {
+                        "description": "Regla no encontrada",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Error interno del servidor",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/sla-policies": {
+            "get": {
+                "description": "Obtiene la política vigente de cada tipo de evento: la configurada o, en su ausencia, la predeterminada",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "sla"
+                ],
+                "summary": "Obtener las políticas de SLA",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/models.SLAPolicy"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Error interno del servidor",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/sla-policies/{type}": {
+            "get": {
+                "description": "Obtiene la política vigente de un tipo de evento",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "sla"
+                ],
+                "summary": "Obtener la política de SLA de un tipo de evento",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Tipo de evento",
+                        "name": "type",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.SLAPolicy"
+                        }
+                    },
+                    "400": {
+                        "description": "Tipo de evento no válido",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Error interno del servidor",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Configura los plazos de revisión y de resolución de un tipo de evento, en minutos. Un plazo de 0 indica que la fase no tiene plazo. Los nuevos plazos se aplican a los eventos que se creen o revisen a partir de ese momento.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "sla"
+                ],
+                "summary": "Configurar la política de SLA de un tipo de evento",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Tipo de evento",
+                        "name": "type",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Plazos del tipo de evento",
+                        "name": "policy",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.UpdateSLAPolicyRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.SLAPolicy"
+                        }
+                    },
+                    "400": {
+                        "description": "Error en los datos de entrada",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Error interno del servidor",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Elimina la política configurada de un tipo de evento, que vuelve a usar los plazos predeterminados",
+                "tags": [
+                    "sla"
+                ],
+                "summary": "Restablecer la política de SLA de un tipo de evento",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Tipo de evento",
+                        "name": "type",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Tipo de evento no válido",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "El tipo de evento no tiene una política configurada",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Error interno del servidor",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/teams": {
+            "get": {
+                "description": "Obtiene todos los equipos por orden de creación",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "teams"
+                ],
+                "summary": "Obtener los equipos",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/models.Team"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Error interno del servidor",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Crea un equipo cuyos miembros reciben por turnos los eventos que requieren gestión",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "teams"
+                ],
+                "summary": "Crear un equipo",
+                "parameters": [
+                    {
+                        "description": "Información del equipo",
+                        "name": "team",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.CreateTeamRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/models.Team"
+                        }
+                    },
+                    "400": {
+                        "description": "Error en los datos de entrada",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Error interno del servidor",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/teams/{id}": {
+            "get": {
+                "description": "Obtiene un equipo por su ID",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "teams"
+                ],
+                "summary": "Obtener un equipo por ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID del equipo",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.Team"
+                        }
+                    },
+                    "404": {
+                        "description": "Equipo no encontrado",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Error interno del servidor",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Actualiza los campos proporcionados de un equipo",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "teams"
+                ],
+                "summary": "Actualizar un equipo",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID del equipo",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Campos a actualizar",
+                        "name": "team",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.UpdateTeamRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.Team"
+                        }
+                    },
+                    "400": {
+                        "description": "Error en los datos de entrada",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Equipo no encontrado",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Error interno del servidor",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Elimina un equipo existente; los eventos asignados conservan su responsable",
+                "tags": [
+                    "teams"
+                ],
+                "summary": "Eliminar un equipo",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID del equipo",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "404": {
+                        "description": "Equipo no encontrado",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Error interno del servidor",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        }
+    },
+    "definitions": {
+        "apierror.Error": {
+            "type": "object",
+            "properties": {
+                "details": {
+                    "description": "Details enumera los campos que no superan la validación, si se conocen",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/apierror.FieldError"
+                    }
+                },
+                "message": {
+                    "type": "string"
+                },
+                "type": {
+                    "$ref": "#/definitions/apierror.Type"
+                }
+            }
+        },
+        "apierror.FieldError": {
+            "type": "object",
+            "properties": {
+                "field": {
+                    "type": "string",
+                    "example": "attributes.window.start"
+                },
+                "message": {
+                    "type": "string",
+                    "example": "missing properties: 'start'"
+                }
+            }
+        },
+        "apierror.Type": {
+            "type": "string",
+            "enum": [
+                "NOT_FOUND",
+                "VALIDATION_FAILED",
+                "RESOURCE_EXISTS",
+                "BAD_REQUEST",
+                "INTERNAL_ERROR",
+                "UNAUTHORIZED",
+                "FORBIDDEN",
+                "PRECONDITION_FAILED",
+                "PRECONDITION_REQUIRED",
+                "UNSUPPORTED_MEDIA_TYPE",
+                "PAYLOAD_TOO_LARGE",
+                "UNPROCESSABLE_ENTITY",
+                "ABORTED"
+            ],
+            "x-enum-varnames": [
+                "NotFound",
+                "ValidationFail",
+                "ResourceExists",
+                "BadRequest",
+                "Internal",
+                "Unauthorized",
+                "Forbidden",
+                "PreconditionFailed",
+                "PreconditionRequired",
+                "UnsupportedMediaType",
+                "PayloadTooLarge",
+                "Unprocessable",
+                "Aborted"
+            ]
+        },
+        "models.AssignEventRequest": {
+            "type": "object",
+            "properties": {
+                "assigneeId": {
+                    "type": "string",
+                    "example": "ana"
+                },
+                "teamId": {
+                    "type": "string",
+                    "example": "6512bd43d9caa6e02c990b0a"
+                }
+            }
+        },
+        "models.Attachment": {
+            "type": "object",
+            "properties": {
+                "checksum": {
+                    "description": "Checksum es el resumen SHA-256 del contenido en hexadecimal",
+                    "type": "string",
+                    "example": "9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08"
+                },
+                "contentType": {
+                    "type": "string",
+                    "example": "image/png"
+                },
+                "fileName": {
+                    "type": "string",
+                    "example": "captura.png"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "size": {
+                    "type": "integer",
+                    "example": 48213
+                },
+                "uploadedAt": {
+                    "type": "string"
+                },
+                "uploadedBy": {
+                    "type": "string",
+                    "example": "ana"
+                }
+            }
+        },
+        "models.BatchEventRequest": {
+            "type": "object",
+            "properties": {
+                "events": {
+                    "type": "array",
+                    "items": {
+                        "type": "object"
+                    }
+                }
+            }
+        },
+        "models.BatchEventResponse": {
+            "type": "object",
+            "properties": {
+                "created": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "failed": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "results": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.BatchEventResult"
+                    }
+                },
+                "total": {
+                    "type": "integer",
+                    "example": 2
+                }
+            }
+        },
+        "models.BatchEventResult": {
+            "type": "object",
+            "properties": {
+                "error": {
+                    "$ref": "#/definitions/apierror.Error"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "6512bd43d9caa6e02c990b0a"
+                },
+                "index": {
+                    "type": "integer",
+                    "example": 0
+                },
+                "success": {
+                    "type": "boolean",
+                    "example": true
+                }
+            }
+        },
+        "models.BulkActionType": {
+            "type": "string",
+            "enum": [
+                "REVIEW",
+                "UNREVIEW",
+                "DELETE",
+                "RETYPE"
+            ],
+            "x-enum-varnames": [
+                "BulkReview",
+                "BulkUnreview",
+                "BulkDelete",
+                "BulkRetype"
+            ]
+        },
+        "models.BulkEventRequest": {
+            "type": "object",
+            "properties": {
+                "action": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/models.BulkActionType"
+                        }
+                    ],
+                    "example": "REVIEW"
+                },
+                "atomic": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "eventType": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/models.EventType"
+                        }
+                    ],
+                    "example": "ALERT"
+                },
+                "filter": {
+                    "$ref": "#/definitions/models.EventFilter"
+                },
+                "justification": {
+                    "type": "string",
+                    "example": "Revisión masiva tras el incidente"
+                },
+                "managementStatus": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/models.ManagementStatus"
+                        }
+                    ],
+                    "example": "REQUIRES_MANAGEMENT"
+                },
+                "operations": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.BulkOperation"
+                    }
+                }
+            }
+        },
+        "models.BulkEventResponse": {
+            "type": "object",
+            "properties": {
+                "atomic": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "failed": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "results": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.BulkOperationResult"
+                    }
+                },
+                "succeeded": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "total": {
+                    "type": "integer",
+                    "example": 2
+                }
+            }
+        },
+        "models.BulkOperation": {
+            "type": "object",
+            "properties": {
+                "action": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/models.BulkActionType"
+                        }
+                    ],
+                    "example": "REVIEW"
+                },
+                "eventType": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/models.EventType"
+                        }
+                    ],
+                    "example": "ALERT"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "6512bd43d9caa6e02c990b0a"
+                },
+                "justification": {
+                    "type": "string",
+                    "example": "Revisión masiva tras el incidente"
+                },
+                "managementStatus": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/models.ManagementStatus"
+                        }
+                    ],
+                    "example": "REQUIRES_MANAGEMENT"
+                },
+                "version": {
+                    "type": "integer",
+                    "example": 3
+                }
+            }
+        },
+        "models.BulkOperationResult": {
+            "type": "object",
+            "properties": {
+                "action": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/models.BulkActionType"
+                        }
+                    ],
+                    "example": "REVIEW"
+                },
+                "error": {
+                    "$ref": "#/definitions/apierror.Error"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "6512bd43d9caa6e02c990b0a"
+                },
+                "index": {
+                    "type": "integer",
+                    "example": 0
+                },
+                "success": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "version": {
+                    "type": "integer",
+                    "example": 4
+                }
+            }
+        },
+        "models.Comment": {
+            "type": "object",
+            "properties": {
+                "author": {
+                    "type": "string",
+                    "example": "ana"
+                },
+                "body": {
+                    "type": "string",
+                    "example": "Revisado con @luis: el **corte** afecta solo al CPD norte"
+                },
+                "createdAt": {
+                    "type": "string"
+                },
+                "edits": {
+                    "description": "Edits son las versiones anteriores del cuerpo, de la más antigua a la más reciente",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.CommentEdit"
+                    }
+                },
+                "eventId": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "mentions": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "luis"
+                    ]
+                },
+                "updatedAt": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.CommentEdit": {
+            "type": "object",
+            "properties": {
+                "body": {
+                    "type": "string",
+                    "example": "Revisado: el corte afecta al CPD norte"
+                },
+                "editedAt": {
+                    "type": "string"
+                },
+                "editedBy": {
+                    "type": "string",
+                    "example": "ana"
+                }
+            }
+        },
+        "models.CommentListResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.Comment"
+                    }
+                },
+                "pagination": {
+                    "$ref": "#/definitions/models.Pagination"
+                }
+            }
+        },
+        "models.CountBucket": {
+            "type": "object",
+            "properties": {
+                "count": {
+                    "type": "integer",
+                    "example": 42
+                },
+                "key": {
+                    "type": "string",
+                    "example": "EMERGENCY"
+                }
+            }
+        },
+        "models.CreateCommentRequest": {
+            "type": "object",
+            "required": [
+                "body"
+            ],
+            "properties": {
+                "body": {
+                    "type": "string",
+                    "example": "Revisado con @luis: el **corte** afecta solo al CPD norte"
+                }
+            }
+        },
+        "models.CreateEscalationPolicyRequest": {
+            "type": "object",
+            "required": [
+                "eventType",
+                "levels",
+                "name"
+            ],
+            "properties": {
+                "enabled": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "eventType": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/models.EventType"
+                        }
+                    ],
+                    "example": "EMERGENCY"
+                },
+                "levels": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.EscalationLevel"
+                    }
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Escalado de emergencias"
+                }
+            }
+        },
+        "models.CreateEventRequest": {
+            "type": "object",
+            "required": [
+                "date",
+                "description",
+                "name",
+                "type"
+            ],
+            "properties": {
+                "attributes": {
+                    "description": "Attributes son los campos personalizados del evento, validados con el esquema de su tipo",
+                    "type": "object",
+                    "additionalProperties": true
+                },
+                "date": {
+                    "type": "string",
+                    "example": "2025-04-08T00:00:00Z"
+                },
+                "description": {
+                    "type": "string",
+                    "example": "Mantenimiento programado del sistema para actualización"
+                },
+                "labels": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Mantenimiento programado"
+                },
+                "priority": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/models.Priority"
+                        }
+                    ],
+                    "example": "P3"
+                },
+                "severity": {
+                    "type": "integer",
+                    "maximum": 5,
+                    "minimum": 1,
+                    "example": 3
+                },
+                "tags": {
+                    "description": "Tags y Labels clasifican el evento libremente, por ejemplo por sistema, región o cliente",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "facturacion",
+                        "base-de-datos"
+                    ]
+                },
+                "type": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/models.EventType"
+                        }
+                    ],
+                    "example": "MAINTENANCE"
+                }
+            }
+        },
+        "models.CreateEventTypeRequest": {
+            "type": "object",
+            "required": [
+                "code",
+                "defaultManagementStatus",
+                "name"
+            ],
+            "properties": {
+                "active": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "attributesSchema": {
+                    "type": "object"
+                },
+                "code": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/models.EventType"
+                        }
+                    ],
+                    "example": "OUTAGE"
+                },
+                "color": {
+                    "type": "string",
+                    "example": "#D32F2F"
+                },
+                "defaultManagementStatus": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/models.ManagementStatus"
+                        }
+                    ],
+                    "example": "REQUIRES_MANAGEMENT"
+                },
+                "defaultPriority": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/models.Priority"
+                        }
+                    ],
+                    "example": "P1"
+                },
+                "defaultSeverity": {
+                    "type": "integer",
+                    "maximum": 5,
+                    "minimum": 1,
+                    "example": 5
+                },
+                "description": {
+                    "type": "string",
+                    "example": "Interrupción total de un servicio en producción"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Caída de servicio"
+                }
+            }
+        },
+        "models.CreateRuleRequest": {
+            "type": "object",
+            "required": [
+                "name",
+                "result"
+            ],
+            "properties": {
+                "conditions": {
+                    "$ref": "#/definitions/models.RuleConditions"
+                },
+                "description": {
+                    "type": "string",
+                    "example": "Las emergencias siempre requieren gestión"
+                },
+                "enabled": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "isDefault": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Emergencias críticas"
+                },
+                "priority": {
+                    "type": "integer",
+                    "example": 10
+                },
+                "result": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/models.ManagementStatus"
+                        }
+                    ],
+                    "example": "REQUIRES_MANAGEMENT"
+                }
+            }
+        },
+        "models.CreateTeamRequest": {
+            "type": "object",
+            "required": [
+                "name"
+            ],
+            "properties": {
+                "autoAssign": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "eventTypes": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.EventType"
+                    },
+                    "example": [
+                        "EMERGENCY",
+                        "ALERT"
+                    ]
+                },
+                "members": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "ana",
+                        "luis"
+                    ]
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Guardia de operaciones"
+                }
+            }
+        },
+        "models.ErrorResponse": {
+            "type": "object",
+            "properties": {
+                "details": {
+                    "description": "Details enumera los campos no válidos en los errores de validación que los identifican",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/apierror.FieldError"
+                    }
+                },
+                "error": {
+                    "type": "string",
+                    "example": "mensaje descriptivo del error"
+                }
+            }
+        },
+        "models.EscalationLevel": {
+            "type": "object",
+            "properties": {
+                "delayMinutes": {
+                    "description": "DelayMinutes es el tiempo sin atender, desde la creación o la reapertura del evento, tras el que se alcanza el nivel",
+                    "type": "integer",
+                    "example": 30
+                },
+                "targets": {
+                    "description": "Targets son los usuarios, equipos o canales a los que se escala el evento",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "ana",
+                        "guardia-operaciones"
+                    ]
+                }
+            }
+        },
+        "models.EscalationPolicy": {
+            "type": "object",
+            "properties": {
+                "createdAt": {
+                    "type": "string"
+                },
+                "enabled": {
+                    "type": "boolean"
+                },
+                "eventType": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/models.EventType"
+                        }
+                    ],
+                    "example": "EMERGENCY"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "levels": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.EscalationLevel"
+                    }
+                },
+                "name": {
+                    "type": "string"
+                },
+                "updatedAt": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.EventEscalation": {
+            "type": "object",
+            "properties": {
+                "acknowledgedAt": {
+                    "type": "string"
+                },
+                "acknowledgedBy": {
+                    "type": "string",
+                    "example": "ana"
+                },
+                "escalatedAt": {
+                    "type": "string"
+                },
+                "level": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "policyId": {
+                    "type": "string"
+                },
+                "startedAt": {
+                    "description": "StartedAt es el inicio del periodo sin atender a partir del que se cuentan los retrasos",
+                    "type": "string"
+                },
+                "targets": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "ana",
+                        "guardia-operaciones"
+                    ]
+                }
+            }
+        },
+        "models.EventFilter": {
+            "type": "object",
+            "properties": {
+                "assigneeIds": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "ana"
+                    ]
+                },
+                "attributes": {
+                    "description": "Attributes exige que el evento tenga, para cada ruta de sus campos personalizados\n(window.start), alguno de los valores indicados",
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        }
+                    }
+                },
+                "createdFrom": {
+                    "type": "string"
+                },
+                "createdTo": {
+                    "type": "string"
+                },
+                "dateFrom": {
+                    "type": "string"
+                },
+                "dateTo": {
+                    "type": "string"
+                },
+                "labels": {
+                    "description": "Labels exige que el evento tenga, para cada clave, alguno de los valores indicados",
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        }
+                    }
+                },
+                "managementStatuses": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.ManagementStatus"
+                    }
+                },
+                "statuses": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.EventStatus"
+                    },
+                    "example": [
+                        "PENDING"
+                    ]
+                },
+                "tags": {
+                    "description": "Tags exige que el evento tenga todas las etiquetas indicadas",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "facturacion"
+                    ]
+                },
+                "teamIds": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "types": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.EventType"
+                    },
+                    "example": [
+                        "ALERT"
+                    ]
+                },
+                "unassigned": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "models.EventHistoryEntry": {
+            "type": "object",
+            "properties": {
+                "actor": {
+                    "type": "string",
+                    "example": "jdoe"
+                },
+                "changes": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.FieldChange"
+                    }
+                },
+                "eventId": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "operation": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/models.HistoryOperation"
+                        }
+                    ],
+                    "example": "REVIEW"
+                },
+                "timestamp": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.EventHistoryListResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.EventHistoryEntry"
+                    }
+                },
+                "pagination": {
+                    "$ref": "#/definitions/models.Pagination"
+                }
+            }
+        },
+        "models.EventListResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.EventResponse"
+                    }
+                },
+                "pagination": {
+                    "$ref": "#/definitions/models.Pagination"
+                }
+            }
+        },
+        "models.EventResponse": {
+            "type": "object",
+            "properties": {
+                "assigneeId": {
+                    "type": "string",
+                    "example": "ana"
+                },
+                "attachments": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.Attachment"
+                    }
+                },
+                "attributes": {
+                    "type": "object",
+                    "additionalProperties": true
+                },
+                "closedAt": {
+                    "type": "string"
+                },
+                "commentCount": {
+                    "type": "integer",
+                    "example": 2
+                },
+                "createdAt": {
+                    "type": "string"
+                },
+                "date": {
+                    "type": "string"
+                },
+                "deletedAt": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "escalation": {
+                    "$ref": "#/definitions/models.EventEscalation"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "justification": {
+                    "type": "string"
+                },
+                "labels": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                },
+                "managementRuleId": {
+                    "type": "string"
+                },
+                "managementRuleName": {
+                    "type": "string",
+                    "example": "Emergencias críticas"
+                },
+                "managementSource": {
+                    "type": "string",
+                    "example": "AUTOMATIC"
+                },
+                "managementStatus": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "priority": {
+                    "type": "string",
+                    "example": "P3"
+                },
+                "reopenedAt": {
+                    "type": "string"
+                },
+                "resolutionNote": {
+                    "type": "string",
+                    "example": "Se reinició el servicio afectado"
+                },
+                "resolvedAt": {
+                    "type": "string"
+                },
+                "score": {
+                    "type": "number"
+                },
+                "severity": {
+                    "type": "integer",
+                    "example": 3
+                },
+                "sla": {
+                    "$ref": "#/definitions/models.SLAStatus"
+                },
+                "startedAt": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string"
+                },
+                "statusNote": {
+                    "type": "string",
+                    "example": "Se reinició el servicio afectado"
+                },
+                "tags": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "facturacion",
+                        "base-de-datos"
+                    ]
+                },
+                "teamId": {
+                    "type": "string",
+                    "example": "6512bd43d9caa6e02c990b0a"
+                },
+                "type": {
+                    "type": "string"
+                },
+                "updatedAt": {
+                    "type": "string"
+                },
+                "version": {
+                    "type": "integer",
+                    "example": 3
+                }
+            }
+        },
+        "models.EventStats": {
+            "type": "object",
+            "properties": {
+                "byManagementStatus": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.CountBucket"
+                    }
+                },
+                "byStatus": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.CountBucket"
+                    }
+                },
+                "byType": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.CountBucket"
+                    }
+                },
+                "dateField": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/models.StatsDateField"
+                        }
+                    ],
+                    "example": "createdAt"
+                },
+                "histogram": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.HistogramBucket"
+                    }
+                },
+                "interval": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/models.StatsInterval"
+                        }
+                    ],
+                    "example": "day"
+                },
+                "total": {
+                    "type": "integer",
+                    "example": 135
+                }
+            }
+        },
+        "models.EventStatus": {
+            "type": "string",
+            "enum": [
+                "PENDING",
+                "REVIEWED",
+                "IN_PROGRESS",
+                "RESOLVED",
+                "CLOSED",
+                "REOPENED"
+            ],
+            "x-enum-varnames": [
+                "StatusPending",
+                "StatusReviewed",
+                "StatusInProgress",
+                "StatusResolved",
+                "StatusClosed",
+                "StatusReopened"
+            ]
+        },
+        "models.EventStatusInfo": {
+            "type": "object",
+            "properties": {
+                "next": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.EventStatus"
+                    },
+                    "example": [
+                        "CLOSED",
+                        "REOPENED"
+                    ]
+                },
+                "status": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/models.EventStatus"
+                        }
+                    ],
+                    "example": "RESOLVED"
+                }
+            }
+        },
+        "models.EventType": {
+            "type": "string",
+            "enum": [
+                "EMERGENCY",
+                "MAINTENANCE",
+                "NOTIFICATION",
+                "ALERT",
+                "INFO"
+            ],
+            "x-enum-varnames": [
+                "TypeEmergency",
+                "TypeMaintenance",
+                "TypeNotification",
+                "TypeAlert",
+                "TypeInfo"
+            ]
+        },
+        "models.EventTypeDefinition": {
+            "type": "object",
+            "properties": {
+                "active": {
+                    "description": "Active indica si se pueden crear eventos del tipo; los eventos existentes lo conservan",
+                    "type": "boolean"
+                },
+                "attributesSchema": {
+                    "type": "object"
+                },
+                "code": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/models.EventType"
+                        }
+                    ],
+                    "example": "OUTAGE"
+                },
+                "color": {
+                    "type": "string",
+                    "example": "#D32F2F"
+                },
+                "createdAt": {
+                    "type": "string"
+                },
+                "defaultManagementStatus": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/models.ManagementStatus"
+                        }
+                    ],
+                    "example": "REQUIRES_MANAGEMENT"
+                },
+                "defaultPriority": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/models.Priority"
+                        }
+                    ],
+                    "example": "P1"
+                },
+                "defaultSeverity": {
+                    "type": "integer",
+                    "example": 5
+                },
+                "description": {
+                    "type": "string",
+                    "example": "Interrupción total de un servicio en producción"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Caída de servicio"
+                },
+                "updatedAt": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.FieldChange": {
+            "type": "object",
+            "properties": {
+                "after": {},
+                "before": {},
+                "field": {
+                    "type": "string",
+                    "example": "status"
+                }
+            }
+        },
+        "models.HistogramBucket": {
+            "type": "object",
+            "properties": {
+                "count": {
+                    "type": "integer",
+                    "example": 12
+                },
+                "period": {
+                    "type": "string",
+                    "example": "2025-04-08"
+                }
+            }
+        },
+        "models.HistoryOperation": {
+            "type": "string",
+            "enum": [
+                "CREATE",
+                "UPDATE",
+                "REVIEW",
+                "UNREVIEW",
+                "DELETE",
+                "RESTORE",
+                "ASSIGN",
+                "UNASSIGN",
+                "TRANSITION",
+                "ESCALATE",
+                "ACKNOWLEDGE",
+                "ATTACH",
+                "DETACH",
+                "TAG",
+                "UNTAG",
+                "PURGE"
+            ],
+            "x-enum-varnames": [
+                "OperationCreate",
+                "OperationUpdate",
+                "OperationReview",
+                "OperationUnreview",
+                "OperationDelete",
+                "OperationRestore",
+                "OperationAssign",
+                "OperationUnassign",
+                "OperationTransition",
+                "OperationEscalate",
+                "OperationAcknowledge",
+                "OperationAttach",
+                "OperationDetach",
+                "OperationTag",
+                "OperationUntag",
+                "OperationPurge"
+            ]
+        },
+        "models.ManagementRule": {
+            "type": "object",
+            "properties": {
+                "conditions": {
+                    "$ref": "#/definitions/models.RuleConditions"
+                },
+                "createdAt": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "enabled": {
+                    "type": "boolean"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "isDefault": {
+                    "type": "boolean"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "priority": {
+                    "type": "integer"
+                },
+                "result": {
+                    "$ref": "#/definitions/models.ManagementStatus"
+                },
+                "updatedAt": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.ManagementStatus": {
+            "type": "string",
+            "enum": [
+                "REQUIRES_MANAGEMENT",
+                "NO_MANAGEMENT"
+            ],
+            "x-enum-varnames": [
+                "ManagementRequired",
+                "ManagementNotRequired"
+            ]
+        },
+        "models.Pagination": {
+            "type": "object",
+            "properties": {
+                "next": {
+                    "type": "string",
+                    "example": "/api/v1/events?page=2\u0026pageSize=20"
+                },
+                "nextCursor": {
+                    "type": "string",
+                    "example": "eyJ0IjoxNzEyNTM0NDAwMDAwLCJpZCI6IjY2MTNmMmE..."
+                },
+                "page": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "pageSize": {
+                    "type": "integer",
+                    "example": 20
+                },
+                "prev": {
+                    "type": "string"
+                },
+                "total": {
+                    "type": "integer",
+                    "example": 135
+                },
+                "totalPages": {
+                    "type": "integer",
+                    "example": 7
+                }
+            }
+        },
+        "models.Priority": {
+            "type": "string",
+            "enum": [
+                "P1",
+                "P2",
+                "P3",
+                "P4"
+            ],
+            "x-enum-varnames": [
+                "PriorityP1",
+                "PriorityP2",
+                "PriorityP3",
+                "PriorityP4"
+            ]
+        },
+        "models.ReviewEventRequest": {
+            "type": "object",
+            "properties": {
+                "comment": {
+                    "description": "Comment se publica, si se indica, en el hilo de comentarios del evento",
+                    "type": "string",
+                    "example": "Revisado con @luis: afecta solo al CPD norte"
+                },
+                "justification": {
+                    "type": "string",
+                    "example": "El mantenimiento afecta a sistemas críticos"
+                },
+                "managementStatus": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/models.ManagementStatus"
                         }
+                    ],
+                    "example": "REQUIRES_MANAGEMENT"
+                }
+            }
+        },
+        "models.RuleConditions": {
+            "type": "object",
+            "properties": {
+                "dateWithinHours": {
+                    "description": "DateWithinHours coincide si la fecha del evento está a lo sumo a ese número de horas del momento de la revisión",
+                    "type": "integer",
+                    "example": 24
+                },
+                "excludeKeywords": {
+                    "description": "ExcludeKeywords descarta el evento si el nombre o la descripción contienen alguna de las palabras",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
                     },
-                    "400": {
-                        "description": "El evento no está en estado revisado",
-                        "schema": {
-                            "$ref": "#/definitions/models.ErrorResponse"
-                        }
+                    "example": [
+                        "prueba"
+                    ]
+                },
+                "keywords": {
+                    "description": "Keywords coincide si el nombre o la descripción contienen alguna de las palabras (sin distinguir mayúsculas)",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
                     },
-                    "404": {
-                        "description": "Evento no encontrado",
-                        "schema": {
-                            "$ref": "#/definitions/models.ErrorResponse"
-                        }
+                    "example": [
+                        "caída",
+                        "intrusión"
+                    ]
+                },
+                "types": {
+                    "description": "Types coincide si el tipo del evento es alguno de los indicados",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.EventType"
                     },
-                    "500": {
-                        "description": "Error interno del servidor",
-                        "schema": {
-                            "$ref": "#/definitions/models.ErrorResponse"
-                        }
-                    }
+                    "example": [
+                        "EMERGENCY",
+                        "ALERT"
+                    ]
                 }
             }
-        }
-    },
-    "definitions": {
-        "models.CreateEventRequest": {
-            "type": "object",
-            "required": [
-                "date",
-                "description",
-                "name",
-                "type"
+        },
+        "models.SLAPhase": {
+            "type": "string",
+            "enum": [
+                "REVIEW",
+                "RESOLVE"
             ],
+            "x-enum-varnames": [
+                "SLAPhaseReview",
+                "SLAPhaseResolve"
+            ]
+        },
+        "models.SLAPolicy": {
+            "type": "object",
             "properties": {
-                "date": {
-                    "type": "string",
-                    "example": "2025-04-08T00:00:00Z"
+                "isDefault": {
+                    "description": "IsDefault indica que el tipo no tiene una política configurada y se aplican los plazos predeterminados",
+                    "type": "boolean"
                 },
-                "description": {
-                    "type": "string",
-                    "example": "Mantenimiento programado del sistema para actualización"
+                "timeToResolveMinutes": {
+                    "description": "TimeToResolveMinutes es el plazo para resolver un evento que requiere gestión desde su revisión",
+                    "type": "integer",
+                    "example": 240
                 },
-                "name": {
-                    "type": "string",
-                    "example": "Mantenimiento programado"
+                "timeToReviewMinutes": {
+                    "description": "TimeToReviewMinutes es el plazo para revisar el evento desde su creación",
+                    "type": "integer",
+                    "example": 15
                 },
                 "type": {
                     "allOf": [
@@ -507,44 +5595,142 @@ const docTemplate = `{
                             "$ref": "#/definitions/models.EventType"
                         }
                     ],
-                    "example": "MAINTENANCE"
+                    "example": "EMERGENCY"
+                },
+                "updatedAt": {
+                    "type": "string"
                 }
             }
         },
-        "models.ErrorResponse": {
+        "models.SLAStatus": {
             "type": "object",
             "properties": {
-                "error": {
+                "breached": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "dueAt": {
+                    "type": "string"
+                },
+                "phase": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/models.SLAPhase"
+                        }
+                    ],
+                    "example": "RESOLVE"
+                },
+                "remaining": {
+                    "type": "integer",
+                    "example": 3600
+                }
+            }
+        },
+        "models.StatsDateField": {
+            "type": "string",
+            "enum": [
+                "date",
+                "createdAt"
+            ],
+            "x-enum-varnames": [
+                "StatsDateFieldDate",
+                "StatsDateFieldCreatedAt"
+            ]
+        },
+        "models.StatsInterval": {
+            "type": "string",
+            "enum": [
+                "day",
+                "week",
+                "month"
+            ],
+            "x-enum-varnames": [
+                "IntervalDay",
+                "IntervalWeek",
+                "IntervalMonth"
+            ]
+        },
+        "models.SuccessResponse": {
+            "type": "object",
+            "properties": {
+                "message": {
                     "type": "string",
-                    "example": "mensaje descriptivo del error"
+                    "example": "operación realizada con éxito"
                 }
             }
         },
-        "models.EventResponse": {
+        "models.TagCount": {
             "type": "object",
             "properties": {
-                "createdAt": {
-                    "type": "string"
+                "count": {
+                    "type": "integer",
+                    "example": 12
                 },
-                "date": {
-                    "type": "string"
+                "tag": {
+                    "type": "string",
+                    "example": "facturacion"
+                }
+            }
+        },
+        "models.TagEventRequest": {
+            "type": "object",
+            "required": [
+                "tags"
+            ],
+            "properties": {
+                "tags": {
+                    "type": "array",
+                    "minItems": 1,
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "facturacion",
+                        "eu-west"
+                    ]
+                }
+            }
+        },
+        "models.Team": {
+            "type": "object",
+            "properties": {
+                "assignmentCount": {
+                    "description": "AssignmentCount es el número de asignaciones realizadas, usado para el reparto por turnos",
+                    "type": "integer"
                 },
-                "description": {
-                    "type": "string"
+                "autoAssign": {
+                    "description": "AutoAssign indica si el equipo recibe automáticamente los eventos que pasan a requerir gestión",
+                    "type": "boolean"
                 },
-                "id": {
+                "createdAt": {
                     "type": "string"
                 },
-                "managementStatus": {
-                    "type": "string"
+                "eventTypes": {
+                    "description": "EventTypes son los tipos de evento que atiende el equipo; si está vacío atiende cualquier tipo",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.EventType"
+                    },
+                    "example": [
+                        "EMERGENCY",
+                        "ALERT"
+                    ]
                 },
-                "name": {
+                "id": {
                     "type": "string"
                 },
-                "status": {
-                    "type": "string"
+                "members": {
+                    "description": "Members son los IDs de usuario de los miembros, en el orden en que reciben eventos",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "ana",
+                        "luis"
+                    ]
                 },
-                "type": {
+                "name": {
                     "type": "string"
                 },
                 "updatedAt": {
@@ -552,35 +5738,62 @@ const docTemplate = `{
                 }
             }
         },
-        "models.EventType": {
-            "type": "string",
-            "enum": [
-                "EMERGENCY",
-                "MAINTENANCE",
-                "NOTIFICATION",
-                "ALERT",
-                "INFO"
+        "models.TransitionEventRequest": {
+            "type": "object",
+            "properties": {
+                "note": {
+                    "type": "string",
+                    "example": "Se reinició el servicio afectado"
+                }
+            }
+        },
+        "models.UpdateCommentRequest": {
+            "type": "object",
+            "required": [
+                "body"
             ],
-            "x-enum-varnames": [
-                "TypeEmergency",
-                "TypeMaintenance",
-                "TypeNotification",
-                "TypeAlert",
-                "TypeInfo"
-            ]
+            "properties": {
+                "body": {
+                    "type": "string",
+                    "example": "Revisado con @luis y @marta: el corte afecta solo al CPD norte"
+                }
+            }
         },
-        "models.SuccessResponse": {
+        "models.UpdateEscalationPolicyRequest": {
             "type": "object",
             "properties": {
-                "message": {
+                "enabled": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "eventType": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/models.EventType"
+                        }
+                    ],
+                    "example": "EMERGENCY"
+                },
+                "levels": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.EscalationLevel"
+                    }
+                },
+                "name": {
                     "type": "string",
-                    "example": "operación realizada con éxito"
+                    "example": "Escalado de emergencias"
                 }
             }
         },
         "models.UpdateEventRequest": {
             "type": "object",
             "properties": {
+                "attributes": {
+                    "description": "Attributes sustituye a los campos personalizados del evento si se indica; un objeto vacío los elimina",
+                    "type": "object",
+                    "additionalProperties": true
+                },
                 "date": {
                     "type": "string",
                     "example": "2025-04-15T00:00:00Z"
@@ -589,10 +5802,40 @@ const docTemplate = `{
                     "type": "string",
                     "example": "Actualización de la descripción del mantenimiento programado"
                 },
+                "labels": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                },
                 "name": {
                     "type": "string",
                     "example": "Actualización de mantenimiento"
                 },
+                "priority": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/models.Priority"
+                        }
+                    ],
+                    "example": "P2"
+                },
+                "severity": {
+                    "type": "integer",
+                    "maximum": 5,
+                    "minimum": 1,
+                    "example": 4
+                },
+                "tags": {
+                    "description": "Tags y Labels sustituyen a los del evento si se indican; una lista o un objeto vacíos los eliminan",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "facturacion"
+                    ]
+                },
                 "type": {
                     "allOf": [
                         {
@@ -602,6 +5845,140 @@ const docTemplate = `{
                     "example": "MAINTENANCE"
                 }
             }
+        },
+        "models.UpdateEventTypeRequest": {
+            "type": "object",
+            "properties": {
+                "active": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "attributesSchema": {
+                    "type": "object"
+                },
+                "color": {
+                    "type": "string",
+                    "example": "#C62828"
+                },
+                "defaultManagementStatus": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/models.ManagementStatus"
+                        }
+                    ],
+                    "example": "REQUIRES_MANAGEMENT"
+                },
+                "defaultPriority": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/models.Priority"
+                        }
+                    ],
+                    "example": "P2"
+                },
+                "defaultSeverity": {
+                    "type": "integer",
+                    "maximum": 5,
+                    "minimum": 1,
+                    "example": 4
+                },
+                "description": {
+                    "type": "string",
+                    "example": "Interrupción total o parcial de un servicio en producción"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Caída de servicio"
+                }
+            }
+        },
+        "models.UpdateRuleRequest": {
+            "type": "object",
+            "properties": {
+                "conditions": {
+                    "$ref": "#/definitions/models.RuleConditions"
+                },
+                "description": {
+                    "type": "string",
+                    "example": "Las emergencias siempre requieren gestión"
+                },
+                "enabled": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "isDefault": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Emergencias críticas"
+                },
+                "priority": {
+                    "type": "integer",
+                    "example": 5
+                },
+                "result": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/models.ManagementStatus"
+                        }
+                    ],
+                    "example": "NO_MANAGEMENT"
+                }
+            }
+        },
+        "models.UpdateSLAPolicyRequest": {
+            "type": "object",
+            "required": [
+                "timeToResolveMinutes",
+                "timeToReviewMinutes"
+            ],
+            "properties": {
+                "timeToResolveMinutes": {
+                    "type": "integer",
+                    "minimum": 0,
+                    "example": 240
+                },
+                "timeToReviewMinutes": {
+                    "type": "integer",
+                    "minimum": 0,
+                    "example": 15
+                }
+            }
+        },
+        "models.UpdateTeamRequest": {
+            "type": "object",
+            "properties": {
+                "autoAssign": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "eventTypes": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.EventType"
+                    },
+                    "example": [
+                        "EMERGENCY"
+                    ]
+                },
+                "members": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "ana",
+                        "luis",
+                        "marta"
+                    ]
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Guardia de operaciones"
+                }
+            }
         }
     }
 }`
@@ -0,0 +1,26 @@
+package actor
+
+import "context"
+
+const (
+	// Anonymous identifica al actor de las solicitudes que no indican usuario
+	Anonymous = "anonymous"
+	// System identifica al actor de las operaciones que realizan las tareas en segundo plano
+	System = "system"
+)
+
+// contextKey es el tipo de las claves de contexto de este paquete
+type contextKey struct{}
+
+// WithID devuelve un contexto que identifica al actor que realiza la operación
+func WithID(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, contextKey{}, id)
+}
+
+// FromContext devuelve el actor que realiza la operación o Anonymous si no se indicó
+func FromContext(ctx context.Context) string {
+	if id, ok := ctx.Value(contextKey{}).(string); ok && id != "" {
+		return id
+	}
+	return Anonymous
+}
@@ -10,19 +10,33 @@ type Type string
 
 // Definición de tipos de errores
 const (
-	NotFound       Type = "NOT_FOUND"
-	ValidationFail Type = "VALIDATION_FAILED"
-	ResourceExists Type = "RESOURCE_EXISTS"
-	BadRequest     Type = "BAD_REQUEST"
-	Internal       Type = "INTERNAL_ERROR"
-	Unauthorized   Type = "UNAUTHORIZED"
-	Forbidden      Type = "FORBIDDEN"
+	NotFound             Type = "NOT_FOUND"
+	ValidationFail       Type = "VALIDATION_FAILED"
+	ResourceExists       Type = "RESOURCE_EXISTS"
+	BadRequest           Type = "BAD_REQUEST"
+	Internal             Type = "INTERNAL_ERROR"
+	Unauthorized         Type = "UNAUTHORIZED"
+	Forbidden            Type = "FORBIDDEN"
+	PreconditionFailed   Type = "PRECONDITION_FAILED"
+	PreconditionRequired Type = "PRECONDITION_REQUIRED"
+	UnsupportedMediaType Type = "UNSUPPORTED_MEDIA_TYPE"
+	PayloadTooLarge      Type = "PAYLOAD_TOO_LARGE"
+	Unprocessable        Type = "UNPROCESSABLE_ENTITY"
+	Aborted              Type = "ABORTED"
 )
 
+// FieldError describe el error de validación de un campo concreto de la solicitud
+type FieldError struct {
+	Field   string `json:"field" example:"attributes.window.start"`
+	Message string `json:"message" example:"missing properties: 'start'"`
+}
+
 // Error es la estructura para errores personalizados
 type Error struct {
 	Type    Type   `json:"type"`
 	Message string `json:"message"`
+	// Details enumera los campos que no superan la validación, si se conocen
+	Details []FieldError `json:"details,omitempty"`
 }
 
 // Error devuelve el mensaje de error
@@ -37,12 +51,22 @@ func (e Error) Status() int {
 		return http.StatusNotFound
 	case ValidationFail, BadRequest:
 		return http.StatusBadRequest
-	case ResourceExists:
+	case ResourceExists, Aborted:
 		return http.StatusConflict
 	case Unauthorized:
 		return http.StatusUnauthorized
 	case Forbidden:
 		return http.StatusForbidden
+	case PreconditionFailed:
+		return http.StatusPreconditionFailed
+	case PreconditionRequired:
+		return http.StatusPreconditionRequired
+	case UnsupportedMediaType:
+		return http.StatusUnsupportedMediaType
+	case PayloadTooLarge:
+		return http.StatusRequestEntityTooLarge
+	case Unprocessable:
+		return http.StatusUnprocessableEntity
 	default:
 		return http.StatusInternalServerError
 	}
@@ -56,6 +80,15 @@ func NewError(errType Type, message string) Error {
 	}
 }
 
+// NewValidationError crea un error de validación con el detalle de los campos no válidos
+func NewValidationError(message string, details []FieldError) Error {
+	return Error{
+		Type:    ValidationFail,
+		Message: message,
+		Details: details,
+	}
+}
+
 // AsError convierte un error a Error personalizado si es posible
 func AsError(err error) (Error, bool) {
 	var apiErr Error
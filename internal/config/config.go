@@ -1,26 +1,83 @@
 package config
 
 import (
+	"log"
 	"os"
+	"strconv"
+	"strings"
+	"time"
 )
 
 // Config representa la configuración de la aplicación
 type Config struct {
-	Port             string
-	MongoURI         string
-	MongoDatabase    string
-	EventsCollection string
-	LogLevel         string
+	Port                   string
+	MongoURI               string
+	MongoDatabase          string
+	EventsCollection       string
+	RulesCollection        string
+	HistoryCollection      string
+	IdempotencyCollection  string
+	TeamsCollection        string
+	SLAPoliciesCollection  string
+	EscalationCollection   string
+	CommentsCollection     string
+	EventTypesCollection   string
+	MigrationsCollection   string
+	LogLevel               string
+	AttachmentStorage      string
+	AttachmentDir          string
+	AttachmentBucket       string
+	MaxAttachmentSize      int64
+	AllowedAttachmentTypes []string
+	TrashRetention         time.Duration
+	PurgeInterval          time.Duration
+	PurgeBatchSize         int
+	IdempotencyTTL         time.Duration
+	SLACheckInterval       time.Duration
+	EscalationInterval     time.Duration
+	EscalationBatchSize    int
+	EventTypeCacheTTL      time.Duration
+	MaxBatchSize           int
+}
+
+// defaultAttachmentTypes son los tipos de contenido de los archivos adjuntos permitidos por defecto
+var defaultAttachmentTypes = []string{
+	"image/png", "image/jpeg", "image/gif", "image/webp",
+	"text/plain", "text/csv", "application/json", "application/pdf",
+	"application/zip", "application/gzip",
 }
 
 // NewConfig crea una nueva instancia de configuración
 func NewConfig() *Config {
 	return &Config{
-		Port:             getEnv("PORT", "8080"),
-		MongoURI:         getEnv("MONGO_URI", "mongodb://localhost:27017"),
-		MongoDatabase:    getEnv("MONGO_DATABASE", "events_db"),
-		EventsCollection: getEnv("EVENTS_COLLECTION", "events"),
-		LogLevel:         getEnv("LOG_LEVEL", "info"),
+		Port:                   getEnv("PORT", "8080"),
+		MongoURI:               getEnv("MONGO_URI", "mongodb://localhost:27017"),
+		MongoDatabase:          getEnv("MONGO_DATABASE", "events_db"),
+		EventsCollection:       getEnv("EVENTS_COLLECTION", "events"),
+		RulesCollection:        getEnv("RULES_COLLECTION", "management_rules"),
+		HistoryCollection:      getEnv("HISTORY_COLLECTION", "event_history"),
+		IdempotencyCollection:  getEnv("IDEMPOTENCY_COLLECTION", "idempotency_keys"),
+		TeamsCollection:        getEnv("TEAMS_COLLECTION", "teams"),
+		SLAPoliciesCollection:  getEnv("SLA_POLICIES_COLLECTION", "sla_policies"),
+		EscalationCollection:   getEnv("ESCALATION_COLLECTION", "escalation_policies"),
+		CommentsCollection:     getEnv("COMMENTS_COLLECTION", "event_comments"),
+		EventTypesCollection:   getEnv("EVENT_TYPES_COLLECTION", "event_types"),
+		MigrationsCollection:   getEnv("MIGRATIONS_COLLECTION", "migrations"),
+		LogLevel:               getEnv("LOG_LEVEL", "info"),
+		AttachmentStorage:      getEnv("ATTACHMENT_STORAGE", "local"),
+		AttachmentDir:          getEnv("ATTACHMENT_DIR", "data/attachments"),
+		AttachmentBucket:       getEnv("ATTACHMENT_BUCKET", "attachments"),
+		MaxAttachmentSize:      int64(getEnvInt("MAX_ATTACHMENT_SIZE", 10<<20)),
+		AllowedAttachmentTypes: getEnvList("ALLOWED_ATTACHMENT_TYPES", defaultAttachmentTypes),
+		TrashRetention:         getEnvDuration("TRASH_RETENTION", 30*24*time.Hour),
+		PurgeInterval:          getEnvDuration("PURGE_INTERVAL", time.Hour),
+		PurgeBatchSize:         getEnvInt("PURGE_BATCH_SIZE", 500),
+		IdempotencyTTL:         getEnvDurationAtLeast("IDEMPOTENCY_TTL", 24*time.Hour, time.Second),
+		SLACheckInterval:       getEnvDuration("SLA_CHECK_INTERVAL", time.Minute),
+		EscalationInterval:     getEnvDuration("ESCALATION_INTERVAL", time.Minute),
+		EscalationBatchSize:    getEnvInt("ESCALATION_BATCH_SIZE", 500),
+		EventTypeCacheTTL:      getEnvDuration("EVENT_TYPE_CACHE_TTL", time.Minute),
+		MaxBatchSize:           getEnvInt("MAX_BATCH_SIZE", 5000),
 	}
 }
 
@@ -31,3 +88,66 @@ func getEnv(key, defaultValue string) string {
 	}
 	return defaultValue
 }
+
+// getEnvDuration obtiene una variable de entorno con formato de duración (por ejemplo "720h")
+// o devuelve un valor predeterminado si no está definida o no es válida
+func getEnvDuration(key string, defaultValue time.Duration) time.Duration {
+	value := os.Getenv(key)
+	if value == "" {
+		return defaultValue
+	}
+
+	duration, err := time.ParseDuration(value)
+	if err != nil || duration <= 0 {
+		log.Printf("Valor inválido para %s (%q), se usa %s\n", key, value, defaultValue)
+		return defaultValue
+	}
+
+	return duration
+}
+
+// getEnvDurationAtLeast obtiene una variable de entorno con formato de duración como
+// getEnvDuration y devuelve el valor predeterminado si es menor que el mínimo indicado
+func getEnvDurationAtLeast(key string, defaultValue, minimum time.Duration) time.Duration {
+	duration := getEnvDuration(key, defaultValue)
+	if duration < minimum {
+		log.Printf("Valor inválido para %s (%s): el mínimo es %s, se usa %s\n", key, duration, minimum, defaultValue)
+		return defaultValue
+	}
+
+	return duration
+}
+
+// getEnvInt obtiene una variable de entorno con un entero positivo o devuelve un valor
+// predeterminado si no está definida o no es válida
+func getEnvInt(key string, defaultValue int) int {
+	value := os.Getenv(key)
+	if value == "" {
+		return defaultValue
+	}
+
+	number, err := strconv.Atoi(value)
+	if err != nil || number <= 0 {
+		log.Printf("Valor inválido para %s (%q), se usa %d\n", key, value, defaultValue)
+		return defaultValue
+	}
+
+	return number
+}
+
+// getEnvList obtiene una variable de entorno con una lista separada por comas o devuelve un
+// valor predeterminado si no está definida o no contiene elementos
+func getEnvList(key string, defaultValue []string) []string {
+	var items []string
+	for _, item := range strings.Split(os.Getenv(key), ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+
+	if len(items) == 0 {
+		return defaultValue
+	}
+
+	return items
+}
@@ -0,0 +1,183 @@
+package handlers
+
+import (
+	"errors"
+	"mime"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+
+	"events-api/internal/apierror"
+	"events-api/internal/config"
+	"events-api/internal/models"
+	"events-api/internal/services"
+)
+
+// multipartOverhead es el margen, en bytes, que se admite en el cuerpo de una subida por encima del
+// tamaño máximo del archivo para las cabeceras y los separadores de multipart/form-data
+const multipartOverhead = 1 << 20
+
+// AttachmentHandler maneja las solicitudes HTTP relacionadas con los archivos adjuntos de eventos
+type AttachmentHandler struct {
+	service services.EventService
+	cfg     *config.Config
+}
+
+// NewAttachmentHandler crea una nueva instancia de AttachmentHandler
+func NewAttachmentHandler(service services.EventService, cfg *config.Config) *AttachmentHandler {
+	return &AttachmentHandler{
+		service: service,
+		cfg:     cfg,
+	}
+}
+
+// GetAttachments godoc
+//
+//	@Summary		Obtener los archivos adjuntos de un evento
+//	@Description	Obtiene los metadatos de los archivos adjuntos de un evento
+//	@Tags			attachments
+//	@Produce		json
+//	@Param			id	path		string	true	"ID del evento"
+//	@Success		200	{array}		models.Attachment
+//	@Failure		400	{object}	models.ErrorResponse	"ID inválido"
+//	@Failure		404	{object}	models.ErrorResponse	"Evento no encontrado"
+//	@Failure		500	{object}	models.ErrorResponse	"Error interno del servidor"
+//	@Router			/events/{id}/attachments [get]
+func (h *AttachmentHandler) GetAttachments(c *gin.Context) {
+	id := c.Param("id")
+	attachments, err := h.service.GetAttachments(c.Request.Context(), id)
+	if err != nil {
+		if apiErr, ok := apierror.AsError(err); ok {
+			c.JSON(apiErr.Status(), gin.H{"error": apiErr.Error()})
+		} else {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, attachments)
+}
+
+// UploadAttachment godoc
+//
+//	@Summary		Adjuntar un archivo a un evento
+//	@Description	Sube un archivo en el campo file de un formulario multipart/form-data y lo adjunta al evento.
+//	@Description	El tamaño máximo y los tipos de contenido permitidos se configuran con MAX_ATTACHMENT_SIZE y ALLOWED_ATTACHMENT_TYPES.
+//	@Description	El tipo de contenido se detecta a partir del contenido del archivo; el tipo declarado y la extensión deben coincidir con él.
+//	@Tags			attachments
+//	@Accept			multipart/form-data
+//	@Produce		json
+//	@Param			id		path		string	true	"ID del evento"
+//	@Param			file	formData	file	true	"Archivo a adjuntar"
+//	@Success		201		{object}	models.Attachment
+//	@Failure		400		{object}	models.ErrorResponse	"Error en los datos de entrada"
+//	@Failure		404		{object}	models.ErrorResponse	"Evento no encontrado"
+//	@Failure		413		{object}	models.ErrorResponse	"El archivo supera el tamaño máximo"
+//	@Failure		415		{object}	models.ErrorResponse	"Tipo de archivo no permitido o que no corresponde a su contenido"
+//	@Failure		500		{object}	models.ErrorResponse	"Error interno del servidor"
+//	@Router			/events/{id}/attachments [post]
+func (h *AttachmentHandler) UploadAttachment(c *gin.Context) {
+	id := c.Param("id")
+	c.Request.Body = http.MaxBytesReader(c.Writer, c.Request.Body, h.cfg.MaxAttachmentSize+multipartOverhead)
+
+	file, err := c.FormFile("file")
+	if err != nil {
+		var maxBytesErr *http.MaxBytesError
+		if errors.As(err, &maxBytesErr) {
+			c.JSON(http.StatusRequestEntityTooLarge, gin.H{"error": "el archivo supera el tamaño máximo de " + strconv.FormatInt(h.cfg.MaxAttachmentSize, 10) + " bytes"})
+			return
+		}
+		c.JSON(http.StatusBadRequest, gin.H{"error": "se requiere un archivo en el campo file: " + err.Error()})
+		return
+	}
+
+	attachment, err := h.service.UploadAttachment(c.Request.Context(), id, file)
+	if err != nil {
+		if apiErr, ok := apierror.AsError(err); ok {
+			c.JSON(apiErr.Status(), gin.H{"error": apiErr.Error()})
+		} else {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		}
+		return
+	}
+
+	c.JSON(http.StatusCreated, attachment)
+}
+
+// DownloadAttachment godoc
+//
+//	@Summary		Descargar un archivo adjunto de un evento
+//	@Description	Descarga el contenido de un archivo adjunto con su tipo de contenido. Admite descargas parciales con la cabecera Range.
+//	@Tags			attachments
+//	@Produce		octet-stream
+//	@Param			id				path		string	true	"ID del evento"
+//	@Param			attachmentId	path		string	true	"ID del archivo adjunto"
+//	@Param			Range			header		string	false	"Rango de bytes (por ejemplo bytes=0-1023)"
+//	@Success		200				{file}		file
+//	@Success		206				{file}		file					"Contenido parcial"
+//	@Header			200				{string}	ETag					"Resumen SHA-256 del contenido"
+//	@Failure		400				{object}	models.ErrorResponse	"ID inválido"
+//	@Failure		404				{object}	models.ErrorResponse	"Evento o archivo adjunto no encontrado"
+//	@Failure		416				{string}	string					"Rango no satisfacible"
+//	@Failure		500				{object}	models.ErrorResponse	"Error interno del servidor"
+//	@Router			/events/{id}/attachments/{attachmentId} [get]
+func (h *AttachmentHandler) DownloadAttachment(c *gin.Context) {
+	id := c.Param("id")
+	attachmentID := c.Param("attachmentId")
+	attachment, content, err := h.service.GetAttachment(c.Request.Context(), id, attachmentID)
+	if err != nil {
+		if apiErr, ok := apierror.AsError(err); ok {
+			c.JSON(apiErr.Status(), gin.H{"error": apiErr.Error()})
+		} else {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		}
+		return
+	}
+	defer content.Close()
+
+	setAttachmentHeaders(c, attachment)
+	http.ServeContent(c.Writer, c.Request, attachment.FileName, attachment.UploadedAt, content)
+}
+
+// DeleteAttachment godoc
+//
+//	@Summary		Eliminar un archivo adjunto de un evento
+//	@Description	Elimina un archivo adjunto de un evento junto a su contenido
+//	@Tags			attachments
+//	@Param			id				path		string	true	"ID del evento"
+//	@Param			attachmentId	path		string	true	"ID del archivo adjunto"
+//	@Success		204				{object}	nil
+//	@Failure		400				{object}	models.ErrorResponse	"ID inválido"
+//	@Failure		404				{object}	models.ErrorResponse	"Evento o archivo adjunto no encontrado"
+//	@Failure		500				{object}	models.ErrorResponse	"Error interno del servidor"
+//	@Router			/events/{id}/attachments/{attachmentId} [delete]
+func (h *AttachmentHandler) DeleteAttachment(c *gin.Context) {
+	id := c.Param("id")
+	attachmentID := c.Param("attachmentId")
+	err := h.service.DeleteAttachment(c.Request.Context(), id, attachmentID)
+	if err != nil {
+		if apiErr, ok := apierror.AsError(err); ok {
+			c.JSON(apiErr.Status(), gin.H{"error": apiErr.Error()})
+		} else {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		}
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// setAttachmentHeaders envía las cabeceras de la descarga de un archivo adjunto. El archivo se
+// descarga siempre como adjunto y sin que el navegador deduzca otro tipo de contenido.
+func setAttachmentHeaders(c *gin.Context, attachment models.Attachment) {
+	disposition := mime.FormatMediaType("attachment", map[string]string{"filename": attachment.FileName})
+	if disposition == "" {
+		disposition = "attachment"
+	}
+
+	c.Header("Content-Type", attachment.ContentType)
+	c.Header("Content-Disposition", disposition)
+	c.Header("X-Content-Type-Options", "nosniff")
+	c.Header("ETag", `"`+attachment.Checksum+`"`)
+}
@@ -0,0 +1,207 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"events-api/internal/apierror"
+	"events-api/internal/models"
+	"events-api/internal/services"
+)
+
+// CommentHandler maneja las solicitudes HTTP relacionadas con los comentarios de eventos
+type CommentHandler struct {
+	service services.CommentService
+}
+
+// NewCommentHandler crea una nueva instancia de CommentHandler
+func NewCommentHandler(service services.CommentService) *CommentHandler {
+	return &CommentHandler{
+		service: service,
+	}
+}
+
+// GetComments godoc
+//
+//	@Summary		Obtener los comentarios de un evento
+//	@Description	Obtiene una página de los comentarios de un evento, del más antiguo al más reciente
+//	@Tags			comments
+//	@Produce		json
+//	@Param			id			path		string	true	"ID del evento"
+//	@Param			page		query		int		false	"Número de página (desde 1)"	default(1)
+//	@Param			pageSize	query		int		false	"Tamaño de página (máximo 100)"	default(20)
+//	@Success		200			{object}	models.CommentListResponse
+//	@Failure		400			{object}	models.ErrorResponse	"Parámetros inválidos"
+//	@Failure		404			{object}	models.ErrorResponse	"Evento no encontrado o sin comentarios"
+//	@Failure		500			{object}	models.ErrorResponse	"Error interno del servidor"
+//	@Router			/events/{id}/comments [get]
+func (h *CommentHandler) GetComments(c *gin.Context) {
+	id := c.Param("id")
+	opts, err := parseListOptions(c)
+	if err != nil {
+		if apiErr, ok := apierror.AsError(err); ok {
+			c.JSON(apiErr.Status(), gin.H{"error": apiErr.Error()})
+		} else {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		}
+		return
+	}
+
+	// El hilo de comentarios tiene un orden fijo
+	if len(opts.Sort) > 0 || opts.Cursor != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "los comentarios no admiten los parámetros sort ni cursor"})
+		return
+	}
+
+	comments, err := h.service.GetComments(c.Request.Context(), id, opts)
+	if err != nil {
+		if apiErr, ok := apierror.AsError(err); ok {
+			c.JSON(apiErr.Status(), gin.H{"error": apiErr.Error()})
+		} else {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		}
+		return
+	}
+
+	// Si no hay comentarios, retorna un 404
+	if comments.Pagination.Total == 0 {
+		c.JSON(http.StatusNotFound, gin.H{"error": "no se encontraron comentarios para el evento"})
+		return
+	}
+
+	setPaginationLinks(c, &comments.Pagination)
+	c.JSON(http.StatusOK, comments)
+}
+
+// CreateComment godoc
+//
+//	@Summary		Publicar un comentario en un evento
+//	@Description	Publica un comentario en Markdown en el hilo de un evento con el usuario de la cabecera X-User-ID como autor. Las menciones (@usuario) se extraen del cuerpo.
+//	@Tags			comments
+//	@Accept			json
+//	@Produce		json
+//	@Param			id		path		string						true	"ID del evento"
+//	@Param			comment	body		models.CreateCommentRequest	true	"Contenido del comentario"
+//	@Success		201		{object}	models.Comment
+//	@Failure		400		{object}	models.ErrorResponse	"Error en los datos de entrada"
+//	@Failure		401		{object}	models.ErrorResponse	"Falta la cabecera X-User-ID"
+//	@Failure		404		{object}	models.ErrorResponse	"Evento no encontrado"
+//	@Failure		500		{object}	models.ErrorResponse	"Error interno del servidor"
+//	@Router			/events/{id}/comments [post]
+func (h *CommentHandler) CreateComment(c *gin.Context) {
+	id := c.Param("id")
+	var req models.CreateCommentRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	comment, err := h.service.CreateComment(c.Request.Context(), id, req)
+	if err != nil {
+		if apiErr, ok := apierror.AsError(err); ok {
+			c.JSON(apiErr.Status(), gin.H{"error": apiErr.Error()})
+		} else {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		}
+		return
+	}
+
+	c.JSON(http.StatusCreated, comment)
+}
+
+// GetComment godoc
+//
+//	@Summary		Obtener un comentario de un evento
+//	@Description	Obtiene un comentario de un evento con su historial de ediciones
+//	@Tags			comments
+//	@Produce		json
+//	@Param			id			path		string	true	"ID del evento"
+//	@Param			commentId	path		string	true	"ID del comentario"
+//	@Success		200			{object}	models.Comment
+//	@Failure		404			{object}	models.ErrorResponse	"Evento o comentario no encontrado"
+//	@Failure		500			{object}	models.ErrorResponse	"Error interno del servidor"
+//	@Router			/events/{id}/comments/{commentId} [get]
+func (h *CommentHandler) GetComment(c *gin.Context) {
+	id := c.Param("id")
+	commentID := c.Param("commentId")
+	comment, err := h.service.GetComment(c.Request.Context(), id, commentID)
+	if err != nil {
+		if apiErr, ok := apierror.AsError(err); ok {
+			c.JSON(apiErr.Status(), gin.H{"error": apiErr.Error()})
+		} else {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, comment)
+}
+
+// UpdateComment godoc
+//
+//	@Summary		Editar un comentario de un evento
+//	@Description	Sustituye el cuerpo de un comentario y conserva la versión anterior en su historial de ediciones. Solo el autor puede editar el comentario.
+//	@Tags			comments
+//	@Accept			json
+//	@Produce		json
+//	@Param			id			path		string						true	"ID del evento"
+//	@Param			commentId	path		string						true	"ID del comentario"
+//	@Param			comment		body		models.UpdateCommentRequest	true	"Nuevo contenido del comentario"
+//	@Success		200			{object}	models.Comment
+//	@Failure		400			{object}	models.ErrorResponse	"Error en los datos de entrada"
+//	@Failure		401			{object}	models.ErrorResponse	"Falta la cabecera X-User-ID"
+//	@Failure		403			{object}	models.ErrorResponse	"El usuario no es el autor del comentario"
+//	@Failure		404			{object}	models.ErrorResponse	"Evento o comentario no encontrado"
+//	@Failure		500			{object}	models.ErrorResponse	"Error interno del servidor"
+//	@Router			/events/{id}/comments/{commentId} [put]
+func (h *CommentHandler) UpdateComment(c *gin.Context) {
+	id := c.Param("id")
+	commentID := c.Param("commentId")
+	var req models.UpdateCommentRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "error en el formato de datos: " + err.Error()})
+		return
+	}
+
+	comment, err := h.service.UpdateComment(c.Request.Context(), id, commentID, req)
+	if err != nil {
+		if apiErr, ok := apierror.AsError(err); ok {
+			c.JSON(apiErr.Status(), gin.H{"error": apiErr.Error()})
+		} else {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, comment)
+}
+
+// DeleteComment godoc
+//
+//	@Summary		Eliminar un comentario de un evento
+//	@Description	Elimina un comentario. Solo el autor puede eliminar el comentario.
+//	@Tags			comments
+//	@Param			id			path		string	true	"ID del evento"
+//	@Param			commentId	path		string	true	"ID del comentario"
+//	@Success		204			{object}	nil
+//	@Failure		401			{object}	models.ErrorResponse	"Falta la cabecera X-User-ID"
+//	@Failure		403			{object}	models.ErrorResponse	"El usuario no es el autor del comentario"
+//	@Failure		404			{object}	models.ErrorResponse	"Evento o comentario no encontrado"
+//	@Failure		500			{object}	models.ErrorResponse	"Error interno del servidor"
+//	@Router			/events/{id}/comments/{commentId} [delete]
+func (h *CommentHandler) DeleteComment(c *gin.Context) {
+	id := c.Param("id")
+	commentID := c.Param("commentId")
+	err := h.service.DeleteComment(c.Request.Context(), id, commentID)
+	if err != nil {
+		if apiErr, ok := apierror.AsError(err); ok {
+			c.JSON(apiErr.Status(), gin.H{"error": apiErr.Error()})
+		} else {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		}
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
@@ -0,0 +1,170 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"events-api/internal/apierror"
+	"events-api/internal/models"
+	"events-api/internal/services"
+)
+
+// EscalationHandler maneja las solicitudes HTTP relacionadas con las políticas de escalado
+type EscalationHandler struct {
+	service services.EscalationService
+}
+
+// NewEscalationHandler crea una nueva instancia de EscalationHandler
+func NewEscalationHandler(service services.EscalationService) *EscalationHandler {
+	return &EscalationHandler{
+		service: service,
+	}
+}
+
+// CreatePolicy godoc
+//
+//	@Summary		Crear una política de escalado
+//	@Description	Crea la cadena de escalado de un tipo de evento. Cada nivel se alcanza cuando el evento lleva sin atender el retraso indicado desde su creación o su reapertura; los retrasos deben ser crecientes.
+//	@Tags			escalation
+//	@Accept			json
+//	@Produce		json
+//	@Param			policy	body		models.CreateEscalationPolicyRequest	true	"Información de la política"
+//	@Success		201		{object}	models.EscalationPolicy
+//	@Failure		400		{object}	models.ErrorResponse	"Error en los datos de entrada"
+//	@Failure		409		{object}	models.ErrorResponse	"Ya existe una política para el tipo de evento"
+//	@Failure		500		{object}	models.ErrorResponse	"Error interno del servidor"
+//	@Router			/escalation-policies [post]
+func (h *EscalationHandler) CreatePolicy(c *gin.Context) {
+	var req models.CreateEscalationPolicyRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	policy, err := h.service.CreatePolicy(c.Request.Context(), req)
+	if err != nil {
+		if apiErr, ok := apierror.AsError(err); ok {
+			c.JSON(apiErr.Status(), gin.H{"error": apiErr.Error()})
+		} else {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		}
+		return
+	}
+
+	c.JSON(http.StatusCreated, policy)
+}
+
+// GetAllPolicies godoc
+//
+//	@Summary		Obtener las políticas de escalado
+//	@Description	Obtiene todas las políticas de escalado por tipo de evento
+//	@Tags			escalation
+//	@Produce		json
+//	@Success		200	{array}		models.EscalationPolicy
+//	@Failure		500	{object}	models.ErrorResponse	"Error interno del servidor"
+//	@Router			/escalation-policies [get]
+func (h *EscalationHandler) GetAllPolicies(c *gin.Context) {
+	policies, err := h.service.GetAllPolicies(c.Request.Context())
+	if err != nil {
+		if apiErr, ok := apierror.AsError(err); ok {
+			c.JSON(apiErr.Status(), gin.H{"error": apiErr.Error()})
+		} else {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		}
+		return
+	}
+
+	if policies == nil {
+		policies = []models.EscalationPolicy{}
+	}
+
+	c.JSON(http.StatusOK, policies)
+}
+
+// GetPolicyByID godoc
+//
+//	@Summary		Obtener una política de escalado por ID
+//	@Description	Obtiene una política de escalado por su ID
+//	@Tags			escalation
+//	@Produce		json
+//	@Param			id	path		string	true	"ID de la política"
+//	@Success		200	{object}	models.EscalationPolicy
+//	@Failure		404	{object}	models.ErrorResponse	"Política no encontrada"
+//	@Failure		500	{object}	models.ErrorResponse	"Error interno del servidor"
+//	@Router			/escalation-policies/{id} [get]
+func (h *EscalationHandler) GetPolicyByID(c *gin.Context) {
+	id := c.Param("id")
+	policy, err := h.service.GetPolicyByID(c.Request.Context(), id)
+	if err != nil {
+		if apiErr, ok := apierror.AsError(err); ok {
+			c.JSON(apiErr.Status(), gin.H{"error": apiErr.Error()})
+		} else {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, policy)
+}
+
+// UpdatePolicy godoc
+//
+//	@Summary		Actualizar una política de escalado
+//	@Description	Actualiza los campos proporcionados de una política de escalado
+//	@Tags			escalation
+//	@Accept			json
+//	@Produce		json
+//	@Param			id		path		string									true	"ID de la política"
+//	@Param			policy	body		models.UpdateEscalationPolicyRequest	true	"Campos a actualizar"
+//	@Success		200		{object}	models.EscalationPolicy
+//	@Failure		400		{object}	models.ErrorResponse	"Error en los datos de entrada"
+//	@Failure		404		{object}	models.ErrorResponse	"Política no encontrada"
+//	@Failure		409		{object}	models.ErrorResponse	"Ya existe una política para el tipo de evento"
+//	@Failure		500		{object}	models.ErrorResponse	"Error interno del servidor"
+//	@Router			/escalation-policies/{id} [put]
+func (h *EscalationHandler) UpdatePolicy(c *gin.Context) {
+	id := c.Param("id")
+	var req models.UpdateEscalationPolicyRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "error en el formato de datos: " + err.Error()})
+		return
+	}
+
+	policy, err := h.service.UpdatePolicy(c.Request.Context(), id, req)
+	if err != nil {
+		if apiErr, ok := apierror.AsError(err); ok {
+			c.JSON(apiErr.Status(), gin.H{"error": apiErr.Error()})
+		} else {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, policy)
+}
+
+// DeletePolicy godoc
+//
+//	@Summary		Eliminar una política de escalado
+//	@Description	Elimina una política de escalado; los eventos ya escalados conservan su nivel
+//	@Tags			escalation
+//	@Param			id	path		string	true	"ID de la política"
+//	@Success		204	{object}	nil
+//	@Failure		404	{object}	models.ErrorResponse	"Política no encontrada"
+//	@Failure		500	{object}	models.ErrorResponse	"Error interno del servidor"
+//	@Router			/escalation-policies/{id} [delete]
+func (h *EscalationHandler) DeletePolicy(c *gin.Context) {
+	id := c.Param("id")
+	err := h.service.DeletePolicy(c.Request.Context(), id)
+	if err != nil {
+		if apiErr, ok := apierror.AsError(err); ok {
+			c.JSON(apiErr.Status(), gin.H{"error": apiErr.Error()})
+		} else {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		}
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
@@ -0,0 +1,41 @@
+package handlers
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	"events-api/internal/apierror"
+	"events-api/internal/models"
+)
+
+// setETag envía la versión del evento en la cabecera ETag
+func setETag(c *gin.Context, version int64) {
+	c.Header("ETag", `"`+strconv.FormatInt(version, 10)+`"`)
+}
+
+// parseIfMatch obtiene la versión esperada del evento de la cabecera If-Match.
+// La cabecera es obligatoria; el valor "*" acepta cualquier versión.
+func parseIfMatch(c *gin.Context) (int64, error) {
+	value := strings.TrimSpace(c.GetHeader("If-Match"))
+	if value == "" {
+		return 0, apierror.NewError(apierror.PreconditionRequired, "se requiere la cabecera If-Match con el ETag del evento")
+	}
+
+	if value == "*" {
+		return models.AnyVersion, nil
+	}
+
+	tag := strings.TrimPrefix(value, "W/")
+	if len(tag) < 2 || !strings.HasPrefix(tag, `"`) || !strings.HasSuffix(tag, `"`) {
+		return 0, apierror.NewError(apierror.BadRequest, "cabecera If-Match inválida")
+	}
+
+	version, err := strconv.ParseInt(tag[1:len(tag)-1], 10, 64)
+	if err != nil || version < 0 {
+		return 0, apierror.NewError(apierror.BadRequest, "cabecera If-Match inválida")
+	}
+
+	return version, nil
+}
@@ -1,11 +1,16 @@
 package handlers
 
 import (
+	"errors"
+	"io"
 	"net/http"
+	"strconv"
 
 	"github.com/gin-gonic/gin"
 
+	"events-api/internal/actor"
 	"events-api/internal/apierror"
+	"events-api/internal/middleware"
 	"events-api/internal/models"
 	"events-api/internal/services"
 )
@@ -25,14 +30,18 @@ func NewEventHandler(service services.EventService) *EventHandler {
 // CreateEvent godoc
 //
 //	@Summary		Crear un nuevo evento
-//	@Description	Crea un nuevo evento con la información proporcionada
+//	@Description	Crea un nuevo evento con la información proporcionada. Los campos personalizados (attributes) se validan con el esquema JSON del tipo de evento; los errores indican en details la ruta de cada campo no válido.
 //	@Tags			events
 //	@Accept			json
 //	@Produce		json
-//	@Param			event	body		models.CreateEventRequest	true	"Información del evento"
-//	@Success		201		{object}	models.EventResponse
-//	@Failure		400		{object}	models.ErrorResponse	"Error en los datos de entrada"
-//	@Failure		500		{object}	models.ErrorResponse	"Error interno del servidor"
+//	@Param			Idempotency-Key	header		string						false	"Clave para reintentar la solicitud sin crear duplicados"
+//	@Param			event			body		models.CreateEventRequest	true	"Información del evento"
+//	@Success		201				{object}	models.EventResponse
+//	@Header			201				{string}	ETag					"Versión del evento"
+//	@Failure		400				{object}	models.ErrorResponse	"Error en los datos de entrada"
+//	@Failure		409				{object}	models.ErrorResponse	"La solicitud con esta clave todavía se está procesando"
+//	@Failure		422				{object}	models.ErrorResponse	"La clave de idempotencia ya se usó con otra solicitud"
+//	@Failure		500				{object}	models.ErrorResponse	"Error interno del servidor"
 //	@Router			/events [post]
 func (h *EventHandler) CreateEvent(c *gin.Context) {
 	var req models.CreateEventRequest
@@ -41,7 +50,778 @@ func (h *EventHandler) CreateEvent(c *gin.Context) {
 		return
 	}
 
-	event, err := h.service.CreateEvent(c.Request.Context(), req)
+	event, err := h.service.CreateEvent(c.Request.Context(), req)
+	if err != nil {
+		if apiErr, ok := apierror.AsError(err); ok {
+			c.JSON(apiErr.Status(), models.ErrorResponse{Error: apiErr.Error(), Details: apiErr.Details})
+		} else {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		}
+		return
+	}
+
+	setETag(c, event.Version)
+	c.JSON(http.StatusCreated, event)
+}
+
+// CreateEvents godoc
+//
+//	@Summary		Crear un lote de eventos
+//	@Description	Crea varios eventos en una sola solicitud. Cada elemento de events tiene el formato de CreateEventRequest y se valida por separado; los eventos válidos se crean aunque otros fallen. La respuesta indica, para cada posición del lote, el ID creado o el error.
+//	@Tags			events
+//	@Accept			json
+//	@Produce		json
+//	@Param			Idempotency-Key	header		string						false	"Clave para reintentar la solicitud sin crear duplicados"
+//	@Param			batch			body		models.BatchEventRequest	true	"Lote de eventos"
+//	@Success		200				{object}	models.BatchEventResponse
+//	@Failure		400				{object}	models.ErrorResponse	"Lote vacío o demasiado grande"
+//	@Failure		500				{object}	models.ErrorResponse	"Error interno del servidor"
+//	@Router			/events/batch [post]
+func (h *EventHandler) CreateEvents(c *gin.Context) {
+	var req models.BatchEventRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "error en el formato de datos: " + err.Error()})
+		return
+	}
+
+	response, err := h.service.CreateEvents(c.Request.Context(), req.Events)
+	if err != nil {
+		if apiErr, ok := apierror.AsError(err); ok {
+			c.JSON(apiErr.Status(), gin.H{"error": apiErr.Error()})
+		} else {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// GetAllEvents godoc
+//
+//	@Summary		Obtener todos los eventos
+//	@Description	Obtiene una lista paginada de eventos. Los filtros admiten varios valores, repitiendo el parámetro o separándolos por comas.
+//	@Description	Con el orden por defecto la respuesta incluye nextCursor, que puede enviarse en el parámetro cursor para paginar de forma estable aunque se inserten eventos nuevos
+//	@Tags			events
+//	@Produce		json
+//	@Param			page				query		int			false	"Número de página (desde 1)"	default(1)
+//	@Param			pageSize			query		int			false	"Tamaño de página (máximo 100)"	default(20)
+//	@Param			cursor				query		string		false	"Cursor opaco devuelto en nextCursor; no se combina con page ni sort"
+//	@Param			sort				query		string		false	"Campos de ordenación separados por comas; prefijo - para orden descendente (date, name, type, status, priority, severity, createdAt, updatedAt)"	example(-date,name)
+//	@Param			type				query		[]string	false	"Tipos de evento"																																	collectionFormat(multi)
+//	@Param			status				query		[]string	false	"Estados del evento"																																collectionFormat(multi)
+//	@Param			managementStatus	query		[]string	false	"Estados de gestión"																																collectionFormat(multi)
+//	@Param			dateFrom			query		string		false	"Fecha del evento desde (RFC3339 o YYYY-MM-DD)"
+//	@Param			dateTo				query		string		false	"Fecha del evento hasta (RFC3339 o YYYY-MM-DD)"
+//	@Param			createdFrom			query		string		false	"Fecha de creación desde (RFC3339 o YYYY-MM-DD)"
+//	@Param			createdTo			query		string		false	"Fecha de creación hasta (RFC3339 o YYYY-MM-DD)"
+//	@Param			assigneeId			query		[]string	false	"Usuarios responsables"	collectionFormat(multi)
+//	@Param			teamId				query		[]string	false	"Equipos responsables"	collectionFormat(multi)
+//	@Param			unassigned			query		bool		false	"Solo eventos sin usuario responsable"
+//	@Param			tag					query		[]string	false	"Etiquetas; el evento debe tener todas"																								collectionFormat(multi)
+//	@Param			label.region		query		[]string	false	"Valores de un atributo, con un parámetro label.<clave> por atributo (por ejemplo label.region=eu)"									collectionFormat(multi)
+//	@Param			attr.source			query		[]string	false	"Valores de un campo personalizado, con un parámetro attr.<ruta> por campo (por ejemplo attr.source=nagios o attr.window.start)"	collectionFormat(multi)
+//	@Success		200					{object}	models.EventListResponse
+//	@Failure		400					{object}	models.ErrorResponse	"Parámetros de consulta inválidos"
+//	@Failure		404					{object}	models.ErrorResponse	"No se encontraron eventos"
+//	@Failure		500					{object}	models.ErrorResponse	"Error interno del servidor"
+//	@Router			/events [get]
+func (h *EventHandler) GetAllEvents(c *gin.Context) {
+	opts, err := parseListOptions(c)
+	if err != nil {
+		if apiErr, ok := apierror.AsError(err); ok {
+			c.JSON(apiErr.Status(), gin.H{"error": apiErr.Error()})
+		} else {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		}
+		return
+	}
+
+	filter, err := parseEventFilter(c)
+	if err != nil {
+		if apiErr, ok := apierror.AsError(err); ok {
+			c.JSON(apiErr.Status(), gin.H{"error": apiErr.Error()})
+		} else {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		}
+		return
+	}
+
+	events, err := h.service.GetAllEvents(c.Request.Context(), filter, opts)
+	if err != nil {
+		if apiErr, ok := apierror.AsError(err); ok {
+			c.JSON(apiErr.Status(), gin.H{"error": apiErr.Error()})
+		} else {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		}
+		return
+	}
+
+	// Si no se encuentran eventos, retorna un 404
+	if events.Pagination.Total == 0 {
+		c.JSON(http.StatusNotFound, gin.H{"error": "no se encontraron eventos"})
+		return
+	}
+
+	setPaginationLinks(c, &events.Pagination)
+	c.JSON(http.StatusOK, events)
+}
+
+// SearchEvents godoc
+//
+//	@Summary		Buscar eventos
+//	@Description	Busca eventos por texto en su nombre y descripción, ordenados por relevancia. Admite los mismos filtros y la misma paginación que el listado de eventos
+//	@Tags			events
+//	@Produce		json
+//	@Param			q					query		string		true	"Texto a buscar"
+//	@Param			page				query		int			false	"Número de página (desde 1)"	default(1)
+//	@Param			pageSize			query		int			false	"Tamaño de página (máximo 100)"	default(20)
+//	@Param			sort				query		string		false	"Criterios de desempate tras la relevancia (date, name, type, status, priority, severity, createdAt, updatedAt)"
+//	@Param			type				query		[]string	false	"Tipos de evento"		collectionFormat(multi)
+//	@Param			status				query		[]string	false	"Estados del evento"	collectionFormat(multi)
+//	@Param			managementStatus	query		[]string	false	"Estados de gestión"	collectionFormat(multi)
+//	@Param			dateFrom			query		string		false	"Fecha del evento desde (RFC3339 o YYYY-MM-DD)"
+//	@Param			dateTo				query		string		false	"Fecha del evento hasta (RFC3339 o YYYY-MM-DD)"
+//	@Param			createdFrom			query		string		false	"Fecha de creación desde (RFC3339 o YYYY-MM-DD)"
+//	@Param			createdTo			query		string		false	"Fecha de creación hasta (RFC3339 o YYYY-MM-DD)"
+//	@Param			assigneeId			query		[]string	false	"Usuarios responsables"	collectionFormat(multi)
+//	@Param			teamId				query		[]string	false	"Equipos responsables"	collectionFormat(multi)
+//	@Param			unassigned			query		bool		false	"Solo eventos sin usuario responsable"
+//	@Param			tag					query		[]string	false	"Etiquetas; el evento debe tener todas"																								collectionFormat(multi)
+//	@Param			label.region		query		[]string	false	"Valores de un atributo, con un parámetro label.<clave> por atributo (por ejemplo label.region=eu)"									collectionFormat(multi)
+//	@Param			attr.source			query		[]string	false	"Valores de un campo personalizado, con un parámetro attr.<ruta> por campo (por ejemplo attr.source=nagios o attr.window.start)"	collectionFormat(multi)
+//	@Success		200					{object}	models.EventListResponse
+//	@Failure		400					{object}	models.ErrorResponse	"Parámetros de consulta inválidos"
+//	@Failure		404					{object}	models.ErrorResponse	"No se encontraron eventos"
+//	@Failure		500					{object}	models.ErrorResponse	"Error interno del servidor"
+//	@Router			/events/search [get]
+func (h *EventHandler) SearchEvents(c *gin.Context) {
+	opts, err := parseListOptions(c)
+	if err != nil {
+		if apiErr, ok := apierror.AsError(err); ok {
+			c.JSON(apiErr.Status(), gin.H{"error": apiErr.Error()})
+		} else {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		}
+		return
+	}
+
+	filter, err := parseEventFilter(c)
+	if err != nil {
+		if apiErr, ok := apierror.AsError(err); ok {
+			c.JSON(apiErr.Status(), gin.H{"error": apiErr.Error()})
+		} else {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		}
+		return
+	}
+
+	events, err := h.service.SearchEvents(c.Request.Context(), c.Query("q"), filter, opts)
+	if err != nil {
+		if apiErr, ok := apierror.AsError(err); ok {
+			c.JSON(apiErr.Status(), gin.H{"error": apiErr.Error()})
+		} else {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		}
+		return
+	}
+
+	// Si no se encuentran eventos, retorna un 404
+	if events.Pagination.Total == 0 {
+		c.JSON(http.StatusNotFound, gin.H{"error": "no se encontraron eventos"})
+		return
+	}
+
+	setPaginationLinks(c, &events.Pagination)
+	c.JSON(http.StatusOK, events)
+}
+
+// GetTriageQueue godoc
+//
+//	@Summary		Obtener la cola de triaje
+//	@Description	Obtiene una lista paginada de los eventos pendientes ordenados por prioridad (de P1 a P4) y, dentro de la misma prioridad, del más antiguo al más reciente
+//	@Tags			events
+//	@Produce		json
+//	@Param			page		query		int	false	"Número de página (desde 1)"	default(1)
+//	@Param			pageSize	query		int	false	"Tamaño de página (máximo 100)"	default(20)
+//	@Success		200			{object}	models.EventListResponse
+//	@Failure		400			{object}	models.ErrorResponse	"Parámetros de consulta inválidos"
+//	@Failure		404			{object}	models.ErrorResponse	"No hay eventos pendientes"
+//	@Failure		500			{object}	models.ErrorResponse	"Error interno del servidor"
+//	@Router			/events/queue [get]
+func (h *EventHandler) GetTriageQueue(c *gin.Context) {
+	opts, err := parseListOptions(c)
+	if err != nil {
+		if apiErr, ok := apierror.AsError(err); ok {
+			c.JSON(apiErr.Status(), gin.H{"error": apiErr.Error()})
+		} else {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		}
+		return
+	}
+
+	// La cola tiene un orden fijo
+	if len(opts.Sort) > 0 || opts.Cursor != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "la cola de triaje no admite los parámetros sort ni cursor"})
+		return
+	}
+
+	events, err := h.service.GetTriageQueue(c.Request.Context(), opts)
+	if err != nil {
+		if apiErr, ok := apierror.AsError(err); ok {
+			c.JSON(apiErr.Status(), gin.H{"error": apiErr.Error()})
+		} else {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		}
+		return
+	}
+
+	// Si no hay eventos pendientes, retorna un 404
+	if events.Pagination.Total == 0 {
+		c.JSON(http.StatusNotFound, gin.H{"error": "no se encontraron eventos"})
+		return
+	}
+
+	setPaginationLinks(c, &events.Pagination)
+	c.JSON(http.StatusOK, events)
+}
+
+// GetOverdueEvents godoc
+//
+//	@Summary		Obtener los eventos con el plazo vencido
+//	@Description	Obtiene una lista paginada de los eventos cuyo plazo vigente ha vencido: los pendientes fuera del plazo de revisión y los que requieren gestión fuera del plazo de resolución, del más antiguo al más reciente
+//	@Tags			events
+//	@Produce		json
+//	@Param			page		query		int	false	"Número de página (desde 1)"	default(1)
+//	@Param			pageSize	query		int	false	"Tamaño de página (máximo 100)"	default(20)
+//	@Success		200			{object}	models.EventListResponse
+//	@Failure		400			{object}	models.ErrorResponse	"Parámetros de consulta inválidos"
+//	@Failure		404			{object}	models.ErrorResponse	"No hay eventos con el plazo vencido"
+//	@Failure		500			{object}	models.ErrorResponse	"Error interno del servidor"
+//	@Router			/events/overdue [get]
+func (h *EventHandler) GetOverdueEvents(c *gin.Context) {
+	opts, err := parseListOptions(c)
+	if err != nil {
+		if apiErr, ok := apierror.AsError(err); ok {
+			c.JSON(apiErr.Status(), gin.H{"error": apiErr.Error()})
+		} else {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		}
+		return
+	}
+
+	// La lista de eventos vencidos tiene un orden fijo
+	if len(opts.Sort) > 0 || opts.Cursor != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "la lista de eventos vencidos no admite los parámetros sort ni cursor"})
+		return
+	}
+
+	events, err := h.service.GetOverdueEvents(c.Request.Context(), opts)
+	if err != nil {
+		if apiErr, ok := apierror.AsError(err); ok {
+			c.JSON(apiErr.Status(), gin.H{"error": apiErr.Error()})
+		} else {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		}
+		return
+	}
+
+	// Si no hay eventos vencidos, retorna un 404
+	if events.Pagination.Total == 0 {
+		c.JSON(http.StatusNotFound, gin.H{"error": "no se encontraron eventos"})
+		return
+	}
+
+	setPaginationLinks(c, &events.Pagination)
+	c.JSON(http.StatusOK, events)
+}
+
+// GetEventStats godoc
+//
+//	@Summary		Obtener estadísticas de eventos
+//	@Description	Obtiene el número de eventos por tipo, estado y estado de gestión, y opcionalmente un histograma por día, semana o mes.
+//	@Description	Admite los mismos filtros que el listado de eventos. Los eventos sin estado de gestión se agrupan como NONE
+//	@Tags			events
+//	@Produce		json
+//	@Param			groupBy				query		[]string	false	"Dimensiones a agrupar (type, status, managementStatus); por defecto todas"	collectionFormat(multi)
+//	@Param			interval			query		string		false	"Intervalo del histograma (day, week, month)"
+//	@Param			dateField			query		string		false	"Campo de fecha del histograma (date, createdAt)"	default(createdAt)
+//	@Param			type				query		[]string	false	"Tipos de evento"									collectionFormat(multi)
+//	@Param			status				query		[]string	false	"Estados del evento"								collectionFormat(multi)
+//	@Param			managementStatus	query		[]string	false	"Estados de gestión"								collectionFormat(multi)
+//	@Param			dateFrom			query		string		false	"Fecha del evento desde (RFC3339 o YYYY-MM-DD)"
+//	@Param			dateTo				query		string		false	"Fecha del evento hasta (RFC3339 o YYYY-MM-DD)"
+//	@Param			createdFrom			query		string		false	"Fecha de creación desde (RFC3339 o YYYY-MM-DD)"
+//	@Param			createdTo			query		string		false	"Fecha de creación hasta (RFC3339 o YYYY-MM-DD)"
+//	@Param			assigneeId			query		[]string	false	"Usuarios responsables"	collectionFormat(multi)
+//	@Param			teamId				query		[]string	false	"Equipos responsables"	collectionFormat(multi)
+//	@Param			unassigned			query		bool		false	"Solo eventos sin usuario responsable"
+//	@Param			tag					query		[]string	false	"Etiquetas; el evento debe tener todas"																								collectionFormat(multi)
+//	@Param			label.region		query		[]string	false	"Valores de un atributo, con un parámetro label.<clave> por atributo (por ejemplo label.region=eu)"									collectionFormat(multi)
+//	@Param			attr.source			query		[]string	false	"Valores de un campo personalizado, con un parámetro attr.<ruta> por campo (por ejemplo attr.source=nagios o attr.window.start)"	collectionFormat(multi)
+//	@Success		200					{object}	models.EventStats
+//	@Failure		400					{object}	models.ErrorResponse	"Parámetros de consulta inválidos"
+//	@Failure		500					{object}	models.ErrorResponse	"Error interno del servidor"
+//	@Router			/events/stats [get]
+func (h *EventHandler) GetEventStats(c *gin.Context) {
+	filter, err := parseEventFilter(c)
+	if err != nil {
+		if apiErr, ok := apierror.AsError(err); ok {
+			c.JSON(apiErr.Status(), gin.H{"error": apiErr.Error()})
+		} else {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		}
+		return
+	}
+
+	stats, err := h.service.GetEventStats(c.Request.Context(), filter, parseStatsOptions(c))
+	if err != nil {
+		if apiErr, ok := apierror.AsError(err); ok {
+			c.JSON(apiErr.Status(), gin.H{"error": apiErr.Error()})
+		} else {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, stats)
+}
+
+// GetEventByID godoc
+//
+//	@Summary		Obtener un evento por ID
+//	@Description	Obtiene un evento por su ID
+//	@Tags			events
+//	@Produce		json
+//	@Param			id	path		string	true	"ID del evento"
+//	@Success		200	{object}	models.EventResponse
+//	@Header			200	{string}	ETag					"Versión del evento"
+//	@Failure		404	{object}	models.ErrorResponse	"Evento no encontrado"
+//	@Failure		500	{object}	models.ErrorResponse	"Error interno del servidor"
+//	@Router			/events/{id} [get]
+func (h *EventHandler) GetEventByID(c *gin.Context) {
+	id := c.Param("id")
+	event, err := h.service.GetEventByID(c.Request.Context(), id)
+	if err != nil {
+		if apiErr, ok := apierror.AsError(err); ok {
+			c.JSON(apiErr.Status(), gin.H{"error": apiErr.Error()})
+		} else {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		}
+		return
+	}
+
+	setETag(c, event.Version)
+	c.JSON(http.StatusOK, event)
+}
+
+// GetEventHistory godoc
+//
+//	@Summary		Obtener el historial de un evento
+//	@Description	Obtiene una página del historial de cambios de un evento, de la entrada más reciente a la más antigua.
+//	@Description	Cada entrada registra el actor, la operación y los valores anteriores y posteriores de cada campo modificado
+//	@Tags			events
+//	@Produce		json
+//	@Param			id			path		string	true	"ID del evento"
+//	@Param			page		query		int		false	"Número de página (desde 1)"	default(1)
+//	@Param			pageSize	query		int		false	"Tamaño de página (máximo 100)"	default(20)
+//	@Success		200			{object}	models.EventHistoryListResponse
+//	@Failure		400			{object}	models.ErrorResponse	"Parámetros inválidos"
+//	@Failure		404			{object}	models.ErrorResponse	"No se encontró historial"
+//	@Failure		500			{object}	models.ErrorResponse	"Error interno del servidor"
+//	@Router			/events/{id}/history [get]
+func (h *EventHandler) GetEventHistory(c *gin.Context) {
+	id := c.Param("id")
+	opts, err := parseListOptions(c)
+	if err != nil {
+		if apiErr, ok := apierror.AsError(err); ok {
+			c.JSON(apiErr.Status(), gin.H{"error": apiErr.Error()})
+		} else {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		}
+		return
+	}
+
+	// El historial tiene un orden fijo
+	if len(opts.Sort) > 0 || opts.Cursor != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "el historial no admite los parámetros sort ni cursor"})
+		return
+	}
+
+	history, err := h.service.GetEventHistory(c.Request.Context(), id, opts)
+	if err != nil {
+		if apiErr, ok := apierror.AsError(err); ok {
+			c.JSON(apiErr.Status(), gin.H{"error": apiErr.Error()})
+		} else {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		}
+		return
+	}
+
+	// Si no hay entradas, retorna un 404
+	if history.Pagination.Total == 0 {
+		c.JSON(http.StatusNotFound, gin.H{"error": "no se encontró historial para el evento"})
+		return
+	}
+
+	setPaginationLinks(c, &history.Pagination)
+	c.JSON(http.StatusOK, history)
+}
+
+// UpdateEvent godoc
+//
+//	@Summary		Actualizar un evento
+//	@Description	Actualiza un evento existente. Los campos personalizados (attributes) se validan con el esquema vigente del tipo de evento solo si cambian ellos o el tipo; los errores indican en details la ruta de cada campo no válido.
+//	@Tags			events
+//	@Accept			json
+//	@Produce		json
+//	@Param			id			path		string						true	"ID del evento"
+//	@Param			If-Match	header		string						true	"ETag del evento (versión esperada) o * para cualquier versión"
+//	@Param			event		body		models.UpdateEventRequest	true	"Información del evento"
+//	@Success		200			{object}	models.EventResponse
+//	@Header			200			{string}	ETag					"Versión del evento"
+//	@Failure		400			{object}	models.ErrorResponse	"Error en los datos de entrada"
+//	@Failure		404			{object}	models.ErrorResponse	"Evento no encontrado"
+//	@Failure		412			{object}	models.ErrorResponse	"El evento fue modificado por otra solicitud"
+//	@Failure		428			{object}	models.ErrorResponse	"Falta la cabecera If-Match"
+//	@Failure		500			{object}	models.ErrorResponse	"Error interno del servidor"
+//	@Router			/events/{id} [put]
+func (h *EventHandler) UpdateEvent(c *gin.Context) {
+	id := c.Param("id")
+	version, err := parseIfMatch(c)
+	if err != nil {
+		if apiErr, ok := apierror.AsError(err); ok {
+			c.JSON(apiErr.Status(), gin.H{"error": apiErr.Error()})
+		} else {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		}
+		return
+	}
+
+	var req models.UpdateEventRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "error en el formato de datos: " + err.Error()})
+		return
+	}
+
+	event, err := h.service.UpdateEvent(c.Request.Context(), id, version, req)
+	if err != nil {
+		if apiErr, ok := apierror.AsError(err); ok {
+			c.JSON(apiErr.Status(), models.ErrorResponse{Error: apiErr.Error(), Details: apiErr.Details})
+		} else {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		}
+		return
+	}
+
+	setETag(c, event.Version)
+	c.JSON(http.StatusOK, event)
+}
+
+// PatchEvent godoc
+//
+//	@Summary		Actualizar parcialmente un evento
+//	@Description	Aplica un JSON Merge Patch (RFC 7396) o un JSON Patch (RFC 6902) sobre los campos editables de un evento. El resultado se valida con las mismas reglas que la creación y solo se persisten los campos que cambian.
+//	@Tags			events
+//	@Accept			application/merge-patch+json
+//	@Accept			application/json-patch+json
+//	@Produce		json
+//	@Param			id			path		string	true	"ID del evento"
+//	@Param			If-Match	header		string	true	"ETag del evento (versión esperada) o * para cualquier versión"
+//	@Param			patch		body		object	true	"Documento de parche"
+//	@Success		200			{object}	models.EventResponse
+//	@Header			200			{string}	ETag					"Versión del evento"
+//	@Failure		400			{object}	models.ErrorResponse	"Parche inválido o evento resultante no válido"
+//	@Failure		404			{object}	models.ErrorResponse	"Evento no encontrado"
+//	@Failure		412			{object}	models.ErrorResponse	"El evento fue modificado por otra solicitud"
+//	@Failure		415			{object}	models.ErrorResponse	"Tipo de contenido no soportado"
+//	@Failure		428			{object}	models.ErrorResponse	"Falta la cabecera If-Match"
+//	@Failure		500			{object}	models.ErrorResponse	"Error interno del servidor"
+//	@Router			/events/{id} [patch]
+func (h *EventHandler) PatchEvent(c *gin.Context) {
+	id := c.Param("id")
+	version, err := parseIfMatch(c)
+	if err != nil {
+		if apiErr, ok := apierror.AsError(err); ok {
+			c.JSON(apiErr.Status(), gin.H{"error": apiErr.Error()})
+		} else {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		}
+		return
+	}
+
+	patch, err := io.ReadAll(c.Request.Body)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "error al leer el parche: " + err.Error()})
+		return
+	}
+
+	event, err := h.service.PatchEvent(c.Request.Context(), id, version, c.ContentType(), patch)
+	if err != nil {
+		if apiErr, ok := apierror.AsError(err); ok {
+			c.JSON(apiErr.Status(), models.ErrorResponse{Error: apiErr.Error(), Details: apiErr.Details})
+		} else {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		}
+		return
+	}
+
+	setETag(c, event.Version)
+	c.JSON(http.StatusOK, event)
+}
+
+// DeleteEvent godoc
+//
+//	@Summary		Eliminar un evento
+//	@Description	Envía un evento a la papelera. Los eventos de la papelera no aparecen en los listados y se eliminan definitivamente tras el periodo de retención
+//	@Tags			events
+//	@Param			id			path		string	true	"ID del evento"
+//	@Param			If-Match	header		string	true	"ETag del evento (versión esperada) o * para cualquier versión"
+//	@Success		204			{object}	nil
+//	@Failure		404			{object}	models.ErrorResponse	"Evento no encontrado"
+//	@Failure		412			{object}	models.ErrorResponse	"El evento fue modificado por otra solicitud"
+//	@Failure		428			{object}	models.ErrorResponse	"Falta la cabecera If-Match"
+//	@Failure		500			{object}	models.ErrorResponse	"Error interno del servidor"
+//	@Router			/events/{id} [delete]
+func (h *EventHandler) DeleteEvent(c *gin.Context) {
+	id := c.Param("id")
+	version, err := parseIfMatch(c)
+	if err != nil {
+		if apiErr, ok := apierror.AsError(err); ok {
+			c.JSON(apiErr.Status(), gin.H{"error": apiErr.Error()})
+		} else {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		}
+		return
+	}
+
+	err = h.service.DeleteEvent(c.Request.Context(), id, version)
+	if err != nil {
+		if apiErr, ok := apierror.AsError(err); ok {
+			c.JSON(apiErr.Status(), gin.H{"error": apiErr.Error()})
+		} else {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		}
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// GetDeletedEvents godoc
+//
+//	@Summary		Obtener la papelera de eventos
+//	@Description	Obtiene una lista paginada de los eventos eliminados, del borrado más reciente al más antiguo
+//	@Tags			events
+//	@Produce		json
+//	@Param			page		query		int	false	"Número de página (desde 1)"	default(1)
+//	@Param			pageSize	query		int	false	"Tamaño de página (máximo 100)"	default(20)
+//	@Success		200			{object}	models.EventListResponse
+//	@Failure		400			{object}	models.ErrorResponse	"Parámetros de consulta inválidos"
+//	@Failure		404			{object}	models.ErrorResponse	"La papelera está vacía"
+//	@Failure		500			{object}	models.ErrorResponse	"Error interno del servidor"
+//	@Router			/events/trash [get]
+func (h *EventHandler) GetDeletedEvents(c *gin.Context) {
+	opts, err := parseListOptions(c)
+	if err != nil {
+		if apiErr, ok := apierror.AsError(err); ok {
+			c.JSON(apiErr.Status(), gin.H{"error": apiErr.Error()})
+		} else {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		}
+		return
+	}
+
+	// La papelera tiene un orden fijo, por fecha de borrado
+	if len(opts.Sort) > 0 || opts.Cursor != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "la papelera no admite los parámetros sort ni cursor"})
+		return
+	}
+
+	events, err := h.service.GetDeletedEvents(c.Request.Context(), opts)
+	if err != nil {
+		if apiErr, ok := apierror.AsError(err); ok {
+			c.JSON(apiErr.Status(), gin.H{"error": apiErr.Error()})
+		} else {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		}
+		return
+	}
+
+	// Si la papelera está vacía, retorna un 404
+	if events.Pagination.Total == 0 {
+		c.JSON(http.StatusNotFound, gin.H{"error": "la papelera está vacía"})
+		return
+	}
+
+	setPaginationLinks(c, &events.Pagination)
+	c.JSON(http.StatusOK, events)
+}
+
+// RestoreEvent godoc
+//
+//	@Summary		Restaurar un evento
+//	@Description	Saca un evento de la papelera
+//	@Tags			events
+//	@Produce		json
+//	@Param			id	path		string	true	"ID del evento"
+//	@Success		200	{object}	models.EventResponse
+//	@Header			200	{string}	ETag					"Versión del evento"
+//	@Failure		400	{object}	models.ErrorResponse	"ID de evento inválido"
+//	@Failure		404	{object}	models.ErrorResponse	"Evento no encontrado en la papelera"
+//	@Failure		500	{object}	models.ErrorResponse	"Error interno del servidor"
+//	@Router			/events/{id}/restore [post]
+func (h *EventHandler) RestoreEvent(c *gin.Context) {
+	id := c.Param("id")
+
+	event, err := h.service.RestoreEvent(c.Request.Context(), id)
+	if err != nil {
+		if apiErr, ok := apierror.AsError(err); ok {
+			c.JSON(apiErr.Status(), gin.H{"error": apiErr.Error()})
+		} else {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		}
+		return
+	}
+
+	setETag(c, event.Version)
+	c.JSON(http.StatusOK, event)
+}
+
+// ReviewEvent godoc
+//
+//	@Summary		Revisar un evento
+//	@Description	Marca un evento como revisado y asigna automáticamente un estado de gestión según las reglas de gestión configuradas.
+//	@Description	Si ninguna regla coincide y no existe regla predeterminada, se clasifica según su tipo.
+//	@Description	Si el cuerpo indica managementStatus junto a una justificación, la clasificación manual sustituye a la automática
+//	@Description	Si el cuerpo incluye comment, se publica como comentario en el hilo del evento
+//	@Tags			events
+//	@Accept			json
+//	@Produce		json
+//	@Param			id			path		string						true	"ID del evento"
+//	@Param			If-Match	header		string						true	"ETag del evento (versión esperada) o * para cualquier versión"
+//	@Param			review		body		models.ReviewEventRequest	false	"Clasificación manual y comentario (opcionales)"
+//	@Success		200			{object}	models.EventResponse
+//	@Header			200			{string}	ETag					"Versión del evento"
+//	@Failure		400			{object}	models.ErrorResponse	"Error en los datos de entrada"
+//	@Failure		401			{object}	models.ErrorResponse	"Falta la cabecera X-User-ID al incluir un comentario"
+//	@Failure		404			{object}	models.ErrorResponse	"Evento no encontrado"
+//	@Failure		412			{object}	models.ErrorResponse	"El evento fue modificado por otra solicitud"
+//	@Failure		428			{object}	models.ErrorResponse	"Falta la cabecera If-Match"
+//	@Failure		500			{object}	models.ErrorResponse	"Error interno del servidor"
+//	@Router			/events/{id}/review [put]
+func (h *EventHandler) ReviewEvent(c *gin.Context) {
+	id := c.Param("id")
+	version, err := parseIfMatch(c)
+	if err != nil {
+		if apiErr, ok := apierror.AsError(err); ok {
+			c.JSON(apiErr.Status(), gin.H{"error": apiErr.Error()})
+		} else {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		}
+		return
+	}
+
+	var req models.ReviewEventRequest
+	// El cuerpo es opcional: sin cuerpo se aplica la clasificación automática
+	if err := c.ShouldBindJSON(&req); err != nil && !errors.Is(err, io.EOF) {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "error en el formato de datos: " + err.Error()})
+		return
+	}
+
+	event, err := h.service.ReviewEvent(c.Request.Context(), id, version, req)
+	if err != nil {
+		if apiErr, ok := apierror.AsError(err); ok {
+			c.JSON(apiErr.Status(), gin.H{"error": apiErr.Error()})
+		} else {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		}
+		return
+	}
+
+	setETag(c, event.Version)
+	c.JSON(http.StatusOK, event)
+}
+
+// UnreviewEvent godoc
+//
+//	@Summary		Deshacer revisión de un evento
+//	@Description	Devuelve un evento del estado revisado al estado pendiente
+//	@Tags			events
+//	@Produce		json
+//	@Param			id			path		string	true	"ID del evento"
+//	@Param			If-Match	header		string	true	"ETag del evento (versión esperada) o * para cualquier versión"
+//	@Success		200			{object}	models.EventResponse
+//	@Header			200			{string}	ETag					"Versión del evento"
+//	@Failure		400			{object}	models.ErrorResponse	"El evento no está en estado revisado"
+//	@Failure		404			{object}	models.ErrorResponse	"Evento no encontrado"
+//	@Failure		412			{object}	models.ErrorResponse	"El evento fue modificado por otra solicitud"
+//	@Failure		428			{object}	models.ErrorResponse	"Falta la cabecera If-Match"
+//	@Failure		500			{object}	models.ErrorResponse	"Error interno del servidor"
+//	@Router			/events/{id}/unreview [put]
+func (h *EventHandler) UnreviewEvent(c *gin.Context) {
+	id := c.Param("id")
+	version, err := parseIfMatch(c)
+	if err != nil {
+		if apiErr, ok := apierror.AsError(err); ok {
+			c.JSON(apiErr.Status(), gin.H{"error": apiErr.Error()})
+		} else {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		}
+		return
+	}
+
+	event, err := h.service.UnreviewEvent(c.Request.Context(), id, version)
+	if err != nil {
+		if apiErr, ok := apierror.AsError(err); ok {
+			c.JSON(apiErr.Status(), gin.H{"error": apiErr.Error()})
+		} else {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		}
+		return
+	}
+
+	setETag(c, event.Version)
+	c.JSON(http.StatusOK, event)
+}
+
+// AssignEvent godoc
+//
+//	@Summary		Asignar un evento
+//	@Description	Asigna un evento a un usuario, a un equipo o a ambos. Si solo se indica el equipo, el evento se asigna por turnos a uno de sus miembros; si se indican ambos, el usuario debe pertenecer al equipo. Si no se indica el equipo, el evento conserva el suyo; un equipo vacío lo quita.
+//	@Tags			events
+//	@Accept			json
+//	@Produce		json
+//	@Param			id			path		string						true	"ID del evento"
+//	@Param			If-Match	header		string						true	"ETag del evento (versión esperada) o * para cualquier versión"
+//	@Param			assignment	body		models.AssignEventRequest	true	"Responsable del evento"
+//	@Success		200			{object}	models.EventResponse
+//	@Header			200			{string}	ETag					"Versión del evento"
+//	@Failure		400			{object}	models.ErrorResponse	"Error en los datos de entrada"
+//	@Failure		404			{object}	models.ErrorResponse	"Evento o equipo no encontrado"
+//	@Failure		412			{object}	models.ErrorResponse	"El evento fue modificado por otra solicitud"
+//	@Failure		428			{object}	models.ErrorResponse	"Falta la cabecera If-Match"
+//	@Failure		500			{object}	models.ErrorResponse	"Error interno del servidor"
+//	@Router			/events/{id}/assign [put]
+func (h *EventHandler) AssignEvent(c *gin.Context) {
+	id := c.Param("id")
+	version, err := parseIfMatch(c)
+	if err != nil {
+		if apiErr, ok := apierror.AsError(err); ok {
+			c.JSON(apiErr.Status(), gin.H{"error": apiErr.Error()})
+		} else {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		}
+		return
+	}
+
+	var req models.AssignEventRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "error en el formato de datos: " + err.Error()})
+		return
+	}
+
+	event, err := h.service.AssignEvent(c.Request.Context(), id, version, req)
 	if err != nil {
 		if apiErr, ok := apierror.AsError(err); ok {
 			c.JSON(apiErr.Status(), gin.H{"error": apiErr.Error()})
@@ -51,21 +831,29 @@ func (h *EventHandler) CreateEvent(c *gin.Context) {
 		return
 	}
 
-	c.JSON(http.StatusCreated, event)
+	setETag(c, event.Version)
+	c.JSON(http.StatusOK, event)
 }
 
-// GetAllEvents godoc
+// UnassignEvent godoc
 //
-//	@Summary		Obtener todos los eventos
-//	@Description	Obtiene una lista de todos los eventos
+//	@Summary		Quitar la asignación de un evento
+//	@Description	Elimina el usuario y el equipo responsables de un evento
 //	@Tags			events
 //	@Produce		json
-//	@Success		200	{array}		models.EventResponse
-//	@Failure		404	{object}	models.ErrorResponse	"No se encontraron eventos"
-//	@Failure		500	{object}	models.ErrorResponse	"Error interno del servidor"
-//	@Router			/events [get]
-func (h *EventHandler) GetAllEvents(c *gin.Context) {
-	events, err := h.service.GetAllEvents(c.Request.Context())
+//	@Param			id			path		string	true	"ID del evento"
+//	@Param			If-Match	header		string	true	"ETag del evento (versión esperada) o * para cualquier versión"
+//	@Success		200			{object}	models.EventResponse
+//	@Header			200			{string}	ETag					"Versión del evento"
+//	@Failure		400			{object}	models.ErrorResponse	"El evento no está asignado"
+//	@Failure		404			{object}	models.ErrorResponse	"Evento no encontrado"
+//	@Failure		412			{object}	models.ErrorResponse	"El evento fue modificado por otra solicitud"
+//	@Failure		428			{object}	models.ErrorResponse	"Falta la cabecera If-Match"
+//	@Failure		500			{object}	models.ErrorResponse	"Error interno del servidor"
+//	@Router			/events/{id}/unassign [put]
+func (h *EventHandler) UnassignEvent(c *gin.Context) {
+	id := c.Param("id")
+	version, err := parseIfMatch(c)
 	if err != nil {
 		if apiErr, ok := apierror.AsError(err); ok {
 			c.JSON(apiErr.Status(), gin.H{"error": apiErr.Error()})
@@ -75,29 +863,57 @@ func (h *EventHandler) GetAllEvents(c *gin.Context) {
 		return
 	}
 
-	// Si no se encuentran eventos, retorna un 404
-	if len(events) == 0 {
-		c.JSON(http.StatusNotFound, gin.H{"error": "no se encontraron eventos"})
+	event, err := h.service.UnassignEvent(c.Request.Context(), id, version)
+	if err != nil {
+		if apiErr, ok := apierror.AsError(err); ok {
+			c.JSON(apiErr.Status(), gin.H{"error": apiErr.Error()})
+		} else {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		}
 		return
 	}
 
-	c.JSON(http.StatusOK, events)
+	setETag(c, event.Version)
+	c.JSON(http.StatusOK, event)
 }
 
-// GetEventByID godoc
+// AddEventTags godoc
 //
-//	@Summary		Obtener un evento por ID
-//	@Description	Obtiene un evento por su ID
+//	@Summary		Añadir etiquetas a un evento
+//	@Description	Añade etiquetas a un evento. Las etiquetas se normalizan a minúsculas y se ignoran las que el evento ya tiene.
 //	@Tags			events
+//	@Accept			json
 //	@Produce		json
-//	@Param			id	path		string	true	"ID del evento"
-//	@Success		200	{object}	models.EventResponse
-//	@Failure		404	{object}	models.ErrorResponse	"Evento no encontrado"
-//	@Failure		500	{object}	models.ErrorResponse	"Error interno del servidor"
-//	@Router			/events/{id} [get]
-func (h *EventHandler) GetEventByID(c *gin.Context) {
+//	@Param			id			path		string					true	"ID del evento"
+//	@Param			If-Match	header		string					true	"ETag del evento (versión esperada) o * para cualquier versión"
+//	@Param			tags		body		models.TagEventRequest	true	"Etiquetas a añadir"
+//	@Success		200			{object}	models.EventResponse
+//	@Header			200			{string}	ETag					"Versión del evento"
+//	@Failure		400			{object}	models.ErrorResponse	"Error en los datos de entrada"
+//	@Failure		404			{object}	models.ErrorResponse	"Evento no encontrado"
+//	@Failure		412			{object}	models.ErrorResponse	"El evento fue modificado por otra solicitud"
+//	@Failure		428			{object}	models.ErrorResponse	"Falta la cabecera If-Match"
+//	@Failure		500			{object}	models.ErrorResponse	"Error interno del servidor"
+//	@Router			/events/{id}/tags [post]
+func (h *EventHandler) AddEventTags(c *gin.Context) {
 	id := c.Param("id")
-	event, err := h.service.GetEventByID(c.Request.Context(), id)
+	version, err := parseIfMatch(c)
+	if err != nil {
+		if apiErr, ok := apierror.AsError(err); ok {
+			c.JSON(apiErr.Status(), gin.H{"error": apiErr.Error()})
+		} else {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		}
+		return
+	}
+
+	var req models.TagEventRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "error en el formato de datos: " + err.Error()})
+		return
+	}
+
+	event, err := h.service.AddEventTags(c.Request.Context(), id, version, req)
 	if err != nil {
 		if apiErr, ok := apierror.AsError(err); ok {
 			c.JSON(apiErr.Status(), gin.H{"error": apiErr.Error()})
@@ -107,32 +923,39 @@ func (h *EventHandler) GetEventByID(c *gin.Context) {
 		return
 	}
 
+	setETag(c, event.Version)
 	c.JSON(http.StatusOK, event)
 }
 
-// UpdateEvent godoc
+// RemoveEventTag godoc
 //
-//	@Summary		Actualizar un evento
-//	@Description	Actualiza un evento existente
+//	@Summary		Quitar una etiqueta de un evento
+//	@Description	Quita una etiqueta de un evento
 //	@Tags			events
-//	@Accept			json
 //	@Produce		json
-//	@Param			id		path		string						true	"ID del evento"
-//	@Param			event	body		models.UpdateEventRequest	true	"Información del evento"
-//	@Success		200		{object}	models.EventResponse
-//	@Failure		400		{object}	models.ErrorResponse	"Error en los datos de entrada"
-//	@Failure		404		{object}	models.ErrorResponse	"Evento no encontrado"
-//	@Failure		500		{object}	models.ErrorResponse	"Error interno del servidor"
-//	@Router			/events/{id} [put]
-func (h *EventHandler) UpdateEvent(c *gin.Context) {
+//	@Param			id			path		string	true	"ID del evento"
+//	@Param			tag			path		string	true	"Etiqueta a quitar"
+//	@Param			If-Match	header		string	true	"ETag del evento (versión esperada) o * para cualquier versión"
+//	@Success		200			{object}	models.EventResponse
+//	@Header			200			{string}	ETag					"Versión del evento"
+//	@Failure		404			{object}	models.ErrorResponse	"Evento no encontrado o sin la etiqueta"
+//	@Failure		412			{object}	models.ErrorResponse	"El evento fue modificado por otra solicitud"
+//	@Failure		428			{object}	models.ErrorResponse	"Falta la cabecera If-Match"
+//	@Failure		500			{object}	models.ErrorResponse	"Error interno del servidor"
+//	@Router			/events/{id}/tags/{tag} [delete]
+func (h *EventHandler) RemoveEventTag(c *gin.Context) {
 	id := c.Param("id")
-	var req models.UpdateEventRequest
-	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "error en el formato de datos: " + err.Error()})
+	version, err := parseIfMatch(c)
+	if err != nil {
+		if apiErr, ok := apierror.AsError(err); ok {
+			c.JSON(apiErr.Status(), gin.H{"error": apiErr.Error()})
+		} else {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		}
 		return
 	}
 
-	event, err := h.service.UpdateEvent(c.Request.Context(), id, req)
+	event, err := h.service.RemoveEventTag(c.Request.Context(), id, version, c.Param("tag"))
 	if err != nil {
 		if apiErr, ok := apierror.AsError(err); ok {
 			c.JSON(apiErr.Status(), gin.H{"error": apiErr.Error()})
@@ -142,22 +965,102 @@ func (h *EventHandler) UpdateEvent(c *gin.Context) {
 		return
 	}
 
+	setETag(c, event.Version)
 	c.JSON(http.StatusOK, event)
 }
 
-// DeleteEvent godoc
+// StartEvent godoc
 //
-//	@Summary		Eliminar un evento
-//	@Description	Elimina un evento existente
+//	@Summary		Iniciar la gestión de un evento
+//	@Description	Pasa un evento revisado o reabierto al estado IN_PROGRESS y registra la fecha de inicio
 //	@Tags			events
-//	@Param			id	path		string	true	"ID del evento"
-//	@Success		204	{object}	nil
-//	@Failure		404	{object}	models.ErrorResponse	"Evento no encontrado"
-//	@Failure		500	{object}	models.ErrorResponse	"Error interno del servidor"
-//	@Router			/events/{id} [delete]
-func (h *EventHandler) DeleteEvent(c *gin.Context) {
+//	@Accept			json
+//	@Produce		json
+//	@Param			id			path		string							true	"ID del evento"
+//	@Param			If-Match	header		string							true	"ETag del evento (versión esperada) o * para cualquier versión"
+//	@Param			transition	body		models.TransitionEventRequest	false	"Nota de la transición"
+//	@Success		200			{object}	models.EventResponse
+//	@Header			200			{string}	ETag					"Versión del evento"
+//	@Failure		400			{object}	models.ErrorResponse	"Transición de estado no permitida"
+//	@Failure		404			{object}	models.ErrorResponse	"Evento no encontrado"
+//	@Failure		412			{object}	models.ErrorResponse	"El evento fue modificado por otra solicitud"
+//	@Failure		428			{object}	models.ErrorResponse	"Falta la cabecera If-Match"
+//	@Failure		500			{object}	models.ErrorResponse	"Error interno del servidor"
+//	@Router			/events/{id}/start [put]
+func (h *EventHandler) StartEvent(c *gin.Context) {
+	h.transitionEvent(c, models.StatusInProgress)
+}
+
+// ResolveEvent godoc
+//
+//	@Summary		Resolver un evento
+//	@Description	Pasa un evento al estado RESOLVED y registra la nota de resolución, que es obligatoria, y la fecha de resolución
+//	@Tags			events
+//	@Accept			json
+//	@Produce		json
+//	@Param			id			path		string							true	"ID del evento"
+//	@Param			If-Match	header		string							true	"ETag del evento (versión esperada) o * para cualquier versión"
+//	@Param			transition	body		models.TransitionEventRequest	true	"Nota de la transición"
+//	@Success		200			{object}	models.EventResponse
+//	@Header			200			{string}	ETag					"Versión del evento"
+//	@Failure		400			{object}	models.ErrorResponse	"Transición de estado no permitida o falta la nota de resolución"
+//	@Failure		404			{object}	models.ErrorResponse	"Evento no encontrado"
+//	@Failure		412			{object}	models.ErrorResponse	"El evento fue modificado por otra solicitud"
+//	@Failure		428			{object}	models.ErrorResponse	"Falta la cabecera If-Match"
+//	@Failure		500			{object}	models.ErrorResponse	"Error interno del servidor"
+//	@Router			/events/{id}/resolve [put]
+func (h *EventHandler) ResolveEvent(c *gin.Context) {
+	h.transitionEvent(c, models.StatusResolved)
+}
+
+// CloseEvent godoc
+//
+//	@Summary		Cerrar un evento
+//	@Description	Pasa un evento resuelto, o revisado sin necesidad de gestión, al estado CLOSED y registra la fecha de cierre
+//	@Tags			events
+//	@Accept			json
+//	@Produce		json
+//	@Param			id			path		string							true	"ID del evento"
+//	@Param			If-Match	header		string							true	"ETag del evento (versión esperada) o * para cualquier versión"
+//	@Param			transition	body		models.TransitionEventRequest	false	"Nota de la transición"
+//	@Success		200			{object}	models.EventResponse
+//	@Header			200			{string}	ETag					"Versión del evento"
+//	@Failure		400			{object}	models.ErrorResponse	"Transición de estado no permitida"
+//	@Failure		404			{object}	models.ErrorResponse	"Evento no encontrado"
+//	@Failure		412			{object}	models.ErrorResponse	"El evento fue modificado por otra solicitud"
+//	@Failure		428			{object}	models.ErrorResponse	"Falta la cabecera If-Match"
+//	@Failure		500			{object}	models.ErrorResponse	"Error interno del servidor"
+//	@Router			/events/{id}/close [put]
+func (h *EventHandler) CloseEvent(c *gin.Context) {
+	h.transitionEvent(c, models.StatusClosed)
+}
+
+// ReopenEvent godoc
+//
+//	@Summary		Reabrir un evento
+//	@Description	Pasa un evento resuelto o cerrado al estado REOPENED, registra la fecha de reapertura y descarta la resolución anterior, que se conserva en el historial
+//	@Tags			events
+//	@Accept			json
+//	@Produce		json
+//	@Param			id			path		string							true	"ID del evento"
+//	@Param			If-Match	header		string							true	"ETag del evento (versión esperada) o * para cualquier versión"
+//	@Param			transition	body		models.TransitionEventRequest	false	"Nota de la transición"
+//	@Success		200			{object}	models.EventResponse
+//	@Header			200			{string}	ETag					"Versión del evento"
+//	@Failure		400			{object}	models.ErrorResponse	"Transición de estado no permitida"
+//	@Failure		404			{object}	models.ErrorResponse	"Evento no encontrado"
+//	@Failure		412			{object}	models.ErrorResponse	"El evento fue modificado por otra solicitud"
+//	@Failure		428			{object}	models.ErrorResponse	"Falta la cabecera If-Match"
+//	@Failure		500			{object}	models.ErrorResponse	"Error interno del servidor"
+//	@Router			/events/{id}/reopen [put]
+func (h *EventHandler) ReopenEvent(c *gin.Context) {
+	h.transitionEvent(c, models.StatusReopened)
+}
+
+// transitionEvent atiende las solicitudes de cambio de estado del ciclo de vida de un evento
+func (h *EventHandler) transitionEvent(c *gin.Context, status models.EventStatus) {
 	id := c.Param("id")
-	err := h.service.DeleteEvent(c.Request.Context(), id)
+	version, err := parseIfMatch(c)
 	if err != nil {
 		if apiErr, ok := apierror.AsError(err); ok {
 			c.JSON(apiErr.Status(), gin.H{"error": apiErr.Error()})
@@ -167,25 +1070,56 @@ func (h *EventHandler) DeleteEvent(c *gin.Context) {
 		return
 	}
 
-	c.Status(http.StatusNoContent)
+	var req models.TransitionEventRequest
+	// El cuerpo es opcional salvo al resolver, donde la nota es obligatoria
+	if err := c.ShouldBindJSON(&req); err != nil && !errors.Is(err, io.EOF) {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "error en el formato de datos: " + err.Error()})
+		return
+	}
+
+	event, err := h.service.TransitionEvent(c.Request.Context(), id, version, status, req)
+	if err != nil {
+		if apiErr, ok := apierror.AsError(err); ok {
+			c.JSON(apiErr.Status(), gin.H{"error": apiErr.Error()})
+		} else {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		}
+		return
+	}
+
+	setETag(c, event.Version)
+	c.JSON(http.StatusOK, event)
 }
 
-// ReviewEvent godoc
+// AcknowledgeEscalation godoc
 //
-//	@Summary		Revisar un evento
-//	@Description	Marca un evento como revisado y asigna automáticamente un estado de gestión según su tipo
+//	@Summary		Confirmar el escalado de un evento
+//	@Description	Confirma la recepción del escalado vigente de un evento, lo que detiene su cadena de escalado hasta que el evento se reabra. Registra el usuario de la cabecera X-User-ID.
 //	@Tags			events
 //	@Produce		json
-//	@Param			id	path		string	true	"ID del evento"
-//	@Success		200	{object}	models.EventResponse
-//	@Failure		404	{object}	models.ErrorResponse	"Evento no encontrado"
-//	@Failure		500	{object}	models.ErrorResponse	"Error interno del servidor"
-//	@Router			/events/{id}/review [put]
-func (h *EventHandler) ReviewEvent(c *gin.Context) {
+//	@Param			id			path		string	true	"ID del evento"
+//	@Param			If-Match	header		string	true	"ETag del evento (versión esperada) o * para cualquier versión"
+//	@Success		200			{object}	models.EventResponse
+//	@Header			200			{string}	ETag					"Versión del evento"
+//	@Failure		400			{object}	models.ErrorResponse	"El evento no tiene un escalado pendiente de confirmar"
+//	@Failure		404			{object}	models.ErrorResponse	"Evento no encontrado"
+//	@Failure		412			{object}	models.ErrorResponse	"El evento fue modificado por otra solicitud"
+//	@Failure		428			{object}	models.ErrorResponse	"Falta la cabecera If-Match"
+//	@Failure		500			{object}	models.ErrorResponse	"Error interno del servidor"
+//	@Router			/events/{id}/acknowledge [put]
+func (h *EventHandler) AcknowledgeEscalation(c *gin.Context) {
 	id := c.Param("id")
-	var req models.ReviewEventRequest
+	version, err := parseIfMatch(c)
+	if err != nil {
+		if apiErr, ok := apierror.AsError(err); ok {
+			c.JSON(apiErr.Status(), gin.H{"error": apiErr.Error()})
+		} else {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		}
+		return
+	}
 
-	event, err := h.service.ReviewEvent(c.Request.Context(), id, req)
+	event, err := h.service.AcknowledgeEscalation(c.Request.Context(), id, version)
 	if err != nil {
 		if apiErr, ok := apierror.AsError(err); ok {
 			c.JSON(apiErr.Status(), gin.H{"error": apiErr.Error()})
@@ -195,25 +1129,36 @@ func (h *EventHandler) ReviewEvent(c *gin.Context) {
 		return
 	}
 
+	setETag(c, event.Version)
 	c.JSON(http.StatusOK, event)
 }
 
-// UnreviewEvent godoc
+// GetMyEvents godoc
 //
-//	@Summary		Deshacer revisión de un evento
-//	@Description	Devuelve un evento del estado revisado al estado pendiente
+//	@Summary		Obtener mis eventos
+//	@Description	Obtiene una lista paginada de los eventos asignados al usuario indicado en la cabecera X-User-ID. Admite los mismos filtros y opciones de paginación que el listado de eventos.
 //	@Tags			events
 //	@Produce		json
-//	@Param			id	path		string	true	"ID del evento"
-//	@Success		200	{object}	models.EventResponse
-//	@Failure		400	{object}	models.ErrorResponse	"El evento no está en estado revisado"
-//	@Failure		404	{object}	models.ErrorResponse	"Evento no encontrado"
-//	@Failure		500	{object}	models.ErrorResponse	"Error interno del servidor"
-//	@Router			/events/{id}/unreview [put]
-func (h *EventHandler) UnreviewEvent(c *gin.Context) {
-	id := c.Param("id")
+//	@Param			X-User-ID	header		string		true	"ID del usuario"
+//	@Param			page		query		int			false	"Número de página (desde 1)"	default(1)
+//	@Param			pageSize	query		int			false	"Tamaño de página (máximo 100)"	default(20)
+//	@Param			sort		query		string		false	"Campos de ordenación separados por comas; prefijo - para orden descendente"
+//	@Param			type		query		[]string	false	"Tipos de evento"		collectionFormat(multi)
+//	@Param			status		query		[]string	false	"Estados del evento"	collectionFormat(multi)
+//	@Success		200			{object}	models.EventListResponse
+//	@Failure		400			{object}	models.ErrorResponse	"Parámetros de consulta inválidos"
+//	@Failure		401			{object}	models.ErrorResponse	"Falta la cabecera X-User-ID"
+//	@Failure		404			{object}	models.ErrorResponse	"No se encontraron eventos"
+//	@Failure		500			{object}	models.ErrorResponse	"Error interno del servidor"
+//	@Router			/events/mine [get]
+func (h *EventHandler) GetMyEvents(c *gin.Context) {
+	userID := actor.FromContext(c.Request.Context())
+	if userID == actor.Anonymous {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "indique el usuario en la cabecera " + middleware.ActorHeader})
+		return
+	}
 
-	event, err := h.service.UnreviewEvent(c.Request.Context(), id)
+	opts, err := parseListOptions(c)
 	if err != nil {
 		if apiErr, ok := apierror.AsError(err); ok {
 			c.JSON(apiErr.Status(), gin.H{"error": apiErr.Error()})
@@ -223,35 +1168,218 @@ func (h *EventHandler) UnreviewEvent(c *gin.Context) {
 		return
 	}
 
-	c.JSON(http.StatusOK, event)
+	filter, err := parseEventFilter(c)
+	if err != nil {
+		if apiErr, ok := apierror.AsError(err); ok {
+			c.JSON(apiErr.Status(), gin.H{"error": apiErr.Error()})
+		} else {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		}
+		return
+	}
+	filter.AssigneeIDs = []string{userID}
+	filter.Unassigned = false
+
+	events, err := h.service.GetAllEvents(c.Request.Context(), filter, opts)
+	if err != nil {
+		if apiErr, ok := apierror.AsError(err); ok {
+			c.JSON(apiErr.Status(), gin.H{"error": apiErr.Error()})
+		} else {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		}
+		return
+	}
+
+	// Si no se encuentran eventos, retorna un 404
+	if events.Pagination.Total == 0 {
+		c.JSON(http.StatusNotFound, gin.H{"error": "no se encontraron eventos"})
+		return
+	}
+
+	setPaginationLinks(c, &events.Pagination)
+	c.JSON(http.StatusOK, events)
+}
+
+// GetUnassignedEvents godoc
+//
+//	@Summary		Obtener eventos sin asignar
+//	@Description	Obtiene una lista paginada de los eventos que requieren gestión y no tienen usuario responsable. Admite los mismos filtros y opciones de paginación que el listado de eventos.
+//	@Tags			events
+//	@Produce		json
+//	@Param			page		query		int			false	"Número de página (desde 1)"	default(1)
+//	@Param			pageSize	query		int			false	"Tamaño de página (máximo 100)"	default(20)
+//	@Param			sort		query		string		false	"Campos de ordenación separados por comas; prefijo - para orden descendente"
+//	@Param			type		query		[]string	false	"Tipos de evento"		collectionFormat(multi)
+//	@Param			teamId		query		[]string	false	"Equipos responsables"	collectionFormat(multi)
+//	@Success		200			{object}	models.EventListResponse
+//	@Failure		400			{object}	models.ErrorResponse	"Parámetros de consulta inválidos"
+//	@Failure		404			{object}	models.ErrorResponse	"No se encontraron eventos"
+//	@Failure		500			{object}	models.ErrorResponse	"Error interno del servidor"
+//	@Router			/events/unassigned [get]
+func (h *EventHandler) GetUnassignedEvents(c *gin.Context) {
+	opts, err := parseListOptions(c)
+	if err != nil {
+		if apiErr, ok := apierror.AsError(err); ok {
+			c.JSON(apiErr.Status(), gin.H{"error": apiErr.Error()})
+		} else {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		}
+		return
+	}
+
+	filter, err := parseEventFilter(c)
+	if err != nil {
+		if apiErr, ok := apierror.AsError(err); ok {
+			c.JSON(apiErr.Status(), gin.H{"error": apiErr.Error()})
+		} else {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		}
+		return
+	}
+	filter.ManagementStatuses = []models.ManagementStatus{models.ManagementRequired}
+	filter.AssigneeIDs = nil
+	filter.Unassigned = true
+
+	events, err := h.service.GetAllEvents(c.Request.Context(), filter, opts)
+	if err != nil {
+		if apiErr, ok := apierror.AsError(err); ok {
+			c.JSON(apiErr.Status(), gin.H{"error": apiErr.Error()})
+		} else {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		}
+		return
+	}
+
+	// Si no se encuentran eventos, retorna un 404
+	if events.Pagination.Total == 0 {
+		c.JSON(http.StatusNotFound, gin.H{"error": "no se encontraron eventos"})
+		return
+	}
+
+	setPaginationLinks(c, &events.Pagination)
+	c.JSON(http.StatusOK, events)
+}
+
+// BulkEvents godoc
+//
+//	@Summary		Operación masiva sobre eventos
+//	@Description	Revisa, deshace la revisión, elimina o cambia el tipo de varios eventos en una sola solicitud. Admite una lista de operaciones o un filtro junto a una acción, y devuelve el resultado de cada operación. Con atomic=true las operaciones se aplican en una transacción: todas o ninguna.
+//	@Tags			events
+//	@Accept			json
+//	@Produce		json
+//	@Param			Idempotency-Key	header		string					false	"Clave para reintentar la solicitud sin aplicarla dos veces"
+//	@Param			request			body		models.BulkEventRequest	true	"Operaciones a aplicar"
+//	@Success		200				{object}	models.BulkEventResponse
+//	@Failure		400				{object}	models.ErrorResponse	"Error en los datos de entrada"
+//	@Failure		404				{object}	models.ErrorResponse	"Ningún evento cumple el filtro"
+//	@Failure		422				{object}	models.ErrorResponse	"MongoDB no admite transacciones para una operación atómica"
+//	@Failure		500				{object}	models.ErrorResponse	"Error interno del servidor"
+//	@Router			/events/bulk [post]
+func (h *EventHandler) BulkEvents(c *gin.Context) {
+	var req models.BulkEventRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "error en el formato de datos: " + err.Error()})
+		return
+	}
+
+	response, err := h.service.BulkEvents(c.Request.Context(), req)
+	if err != nil {
+		if apiErr, ok := apierror.AsError(err); ok {
+			c.JSON(apiErr.Status(), gin.H{"error": apiErr.Error()})
+		} else {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
 }
 
 // GetEventTypes godoc
 //
 //	@Summary		Obtener tipos de eventos
-//	@Description	Obtiene una lista de los tipos de eventos disponibles
+//	@Description	Obtiene los códigos de los tipos de evento activos en el registro. La definición completa de cada tipo se obtiene en /event-types
 //	@Tags			events
 //	@Produce		json
-//	@Success		200	{array}	string
+//	@Success		200	{array}		string
+//	@Failure		500	{object}	models.ErrorResponse	"Error interno del servidor"
 //	@Router			/events/types [get]
 func (h *EventHandler) GetEventTypes(c *gin.Context) {
-	types := h.service.GetEventTypes(c.Request.Context())
+	types, err := h.service.GetEventTypes(c.Request.Context())
+	if err != nil {
+		if apiErr, ok := apierror.AsError(err); ok {
+			c.JSON(apiErr.Status(), gin.H{"error": apiErr.Error()})
+		} else {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		}
+		return
+	}
+
 	c.JSON(http.StatusOK, types)
 }
 
+// GetEventTags godoc
+//
+//	@Summary		Obtener las etiquetas en uso
+//	@Description	Obtiene las etiquetas de los eventos que comienzan por el prefijo indicado, de la más usada a la menos usada, para autocompletar
+//	@Tags			events
+//	@Produce		json
+//	@Param			prefix	query		string	false	"Prefijo de las etiquetas"
+//	@Param			limit	query		int		false	"Número máximo de etiquetas (máximo 100)"	default(20)
+//	@Success		200		{array}		models.TagCount
+//	@Failure		400		{object}	models.ErrorResponse	"Parámetros inválidos"
+//	@Failure		500		{object}	models.ErrorResponse	"Error interno del servidor"
+//	@Router			/events/tags [get]
+func (h *EventHandler) GetEventTags(c *gin.Context) {
+	limit := 0
+	if value := c.Query("limit"); value != "" {
+		parsed, err := strconv.Atoi(value)
+		if err != nil || parsed < 1 || parsed > models.MaxTagSuggestions {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "el parámetro limit debe ser un entero entre 1 y " + strconv.Itoa(models.MaxTagSuggestions)})
+			return
+		}
+		limit = parsed
+	}
+
+	tags, err := h.service.GetEventTags(c.Request.Context(), c.Query("prefix"), limit)
+	if err != nil {
+		if apiErr, ok := apierror.AsError(err); ok {
+			c.JSON(apiErr.Status(), gin.H{"error": apiErr.Error()})
+		} else {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, tags)
+}
+
 // GetEventStatus godoc
 //
 //	@Summary		Obtener estados de eventos
-//	@Description	Obtiene una lista de los estados de eventos disponibles
+//	@Description	Obtiene los estados del ciclo de vida de los eventos con los estados a los que puede pasar cada uno
 //	@Tags			events
 //	@Produce		json
-//	@Success		200	{array}	string
+//	@Success		200	{array}	models.EventStatusInfo
 //	@Router			/events/status [get]
 func (h *EventHandler) GetEventStatus(c *gin.Context) {
 	status := h.service.GetEventStatus(c.Request.Context())
 	c.JSON(http.StatusOK, status)
 }
 
+// GetEventPriorities godoc
+//
+//	@Summary		Obtener prioridades de eventos
+//	@Description	Obtiene una lista de las prioridades de eventos disponibles, de la más urgente a la menos urgente
+//	@Tags			events
+//	@Produce		json
+//	@Success		200	{array}	string
+//	@Router			/events/priorities [get]
+func (h *EventHandler) GetEventPriorities(c *gin.Context) {
+	priorities := h.service.GetEventPriorities(c.Request.Context())
+	c.JSON(http.StatusOK, priorities)
+}
+
 // GetEventManagementStatus godoc
 //
 //	@Summary		Obtener estados de gestión de eventos
@@ -271,8 +1399,11 @@ func (h *EventHandler) GetEventManagementStatus(c *gin.Context) {
 //	@Description	Genera eventos de ejemplo para pruebas
 //	@Tags			events
 //	@Produce		json
-//	@Success		201	{object}	models.SuccessResponse	"Eventos generados correctamente"
-//	@Failure		500	{object}	models.ErrorResponse	"Error interno del servidor"
+//	@Param			Idempotency-Key	header		string					false	"Clave para reintentar la solicitud sin generar duplicados"
+//	@Success		201				{object}	models.SuccessResponse	"Eventos generados correctamente"
+//	@Failure		409				{object}	models.ErrorResponse	"La solicitud con esta clave todavía se está procesando"
+//	@Failure		422				{object}	models.ErrorResponse	"La clave de idempotencia ya se usó con otra solicitud"
+//	@Failure		500				{object}	models.ErrorResponse	"Error interno del servidor"
 //	@Router			/events/seed [post]
 func (h *EventHandler) SeedEvents(c *gin.Context) {
 	err := h.service.SeedEvents(c.Request.Context())
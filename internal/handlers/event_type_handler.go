@@ -0,0 +1,170 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"events-api/internal/apierror"
+	"events-api/internal/models"
+	"events-api/internal/services"
+)
+
+// EventTypeHandler maneja las solicitudes HTTP relacionadas con el registro de tipos de evento
+type EventTypeHandler struct {
+	service services.EventTypeService
+}
+
+// NewEventTypeHandler crea una nueva instancia de EventTypeHandler
+func NewEventTypeHandler(service services.EventTypeService) *EventTypeHandler {
+	return &EventTypeHandler{
+		service: service,
+	}
+}
+
+// CreateEventType godoc
+//
+//	@Summary		Registrar un tipo de evento
+//	@Description	Registra un tipo de evento con su nombre, color y valores predeterminados. El código se normaliza a mayúsculas y no se puede cambiar. Si se indica attributesSchema, los campos personalizados de los eventos del tipo deben cumplir ese esquema JSON (draft 2020-12 salvo que indique $schema; no admite referencias externas).
+//	@Tags			event-types
+//	@Accept			json
+//	@Produce		json
+//	@Param			eventType	body		models.CreateEventTypeRequest	true	"Información del tipo de evento"
+//	@Success		201			{object}	models.EventTypeDefinition
+//	@Failure		400			{object}	models.ErrorResponse	"Error en los datos de entrada"
+//	@Failure		409			{object}	models.ErrorResponse	"Ya existe un tipo de evento con el código indicado"
+//	@Failure		500			{object}	models.ErrorResponse	"Error interno del servidor"
+//	@Router			/event-types [post]
+func (h *EventTypeHandler) CreateEventType(c *gin.Context) {
+	var req models.CreateEventTypeRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	eventType, err := h.service.CreateEventType(c.Request.Context(), req)
+	if err != nil {
+		if apiErr, ok := apierror.AsError(err); ok {
+			c.JSON(apiErr.Status(), gin.H{"error": apiErr.Error()})
+		} else {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		}
+		return
+	}
+
+	c.JSON(http.StatusCreated, eventType)
+}
+
+// GetEventTypes godoc
+//
+//	@Summary		Obtener los tipos de evento
+//	@Description	Obtiene todos los tipos de evento registrados, activos e inactivos, ordenados por código
+//	@Tags			event-types
+//	@Produce		json
+//	@Success		200	{array}		models.EventTypeDefinition
+//	@Failure		500	{object}	models.ErrorResponse	"Error interno del servidor"
+//	@Router			/event-types [get]
+func (h *EventTypeHandler) GetEventTypes(c *gin.Context) {
+	eventTypes, err := h.service.GetEventTypes(c.Request.Context())
+	if err != nil {
+		if apiErr, ok := apierror.AsError(err); ok {
+			c.JSON(apiErr.Status(), gin.H{"error": apiErr.Error()})
+		} else {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		}
+		return
+	}
+
+	if eventTypes == nil {
+		eventTypes = []models.EventTypeDefinition{}
+	}
+
+	c.JSON(http.StatusOK, eventTypes)
+}
+
+// GetEventType godoc
+//
+//	@Summary		Obtener un tipo de evento
+//	@Description	Obtiene un tipo de evento por su código
+//	@Tags			event-types
+//	@Produce		json
+//	@Param			code	path		string	true	"Código del tipo de evento"
+//	@Success		200		{object}	models.EventTypeDefinition
+//	@Failure		404		{object}	models.ErrorResponse	"Tipo de evento no encontrado"
+//	@Failure		500		{object}	models.ErrorResponse	"Error interno del servidor"
+//	@Router			/event-types/{code} [get]
+func (h *EventTypeHandler) GetEventType(c *gin.Context) {
+	code := models.EventType(c.Param("code"))
+	eventType, err := h.service.GetEventType(c.Request.Context(), code)
+	if err != nil {
+		if apiErr, ok := apierror.AsError(err); ok {
+			c.JSON(apiErr.Status(), gin.H{"error": apiErr.Error()})
+		} else {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, eventType)
+}
+
+// UpdateEventType godoc
+//
+//	@Summary		Actualizar un tipo de evento
+//	@Description	Actualiza los campos proporcionados de un tipo de evento. Desactivar un tipo impide crear eventos nuevos de ese tipo; los existentes lo conservan. Un attributesSchema null elimina el esquema; los eventos existentes no se vuelven a validar hasta que se modifiquen sus campos personalizados o su tipo.
+//	@Tags			event-types
+//	@Accept			json
+//	@Produce		json
+//	@Param			code		path		string							true	"Código del tipo de evento"
+//	@Param			eventType	body		models.UpdateEventTypeRequest	true	"Campos a actualizar"
+//	@Success		200			{object}	models.EventTypeDefinition
+//	@Failure		400			{object}	models.ErrorResponse	"Error en los datos de entrada"
+//	@Failure		404			{object}	models.ErrorResponse	"Tipo de evento no encontrado"
+//	@Failure		500			{object}	models.ErrorResponse	"Error interno del servidor"
+//	@Router			/event-types/{code} [put]
+func (h *EventTypeHandler) UpdateEventType(c *gin.Context) {
+	code := models.EventType(c.Param("code"))
+	var req models.UpdateEventTypeRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	eventType, err := h.service.UpdateEventType(c.Request.Context(), code, req)
+	if err != nil {
+		if apiErr, ok := apierror.AsError(err); ok {
+			c.JSON(apiErr.Status(), gin.H{"error": apiErr.Error()})
+		} else {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, eventType)
+}
+
+// DeleteEventType godoc
+//
+//	@Summary		Eliminar un tipo de evento
+//	@Description	Elimina un tipo de evento que no usa ningún evento, incluidos los de la papelera, ni ninguna regla, equipo o política. Los tipos en uso deben desactivarse.
+//	@Tags			event-types
+//	@Param			code	path		string	true	"Código del tipo de evento"
+//	@Success		204		{object}	nil
+//	@Failure		404		{object}	models.ErrorResponse	"Tipo de evento no encontrado"
+//	@Failure		409		{object}	models.ErrorResponse	"El tipo de evento está en uso"
+//	@Failure		500		{object}	models.ErrorResponse	"Error interno del servidor"
+//	@Router			/event-types/{code} [delete]
+func (h *EventTypeHandler) DeleteEventType(c *gin.Context) {
+	code := models.EventType(c.Param("code"))
+	err := h.service.DeleteEventType(c.Request.Context(), code)
+	if err != nil {
+		if apiErr, ok := apierror.AsError(err); ok {
+			c.JSON(apiErr.Status(), gin.H{"error": apiErr.Error()})
+		} else {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		}
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
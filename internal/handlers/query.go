@@ -0,0 +1,283 @@
+package handlers
+
+import (
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"events-api/internal/apierror"
+	"events-api/internal/models"
+)
+
+// Prefijos de los parámetros de consulta que filtran por atributo y por campo personalizado
+const (
+	labelParamPrefix     = "label."
+	attributeParamPrefix = "attr."
+)
+
+// parseListOptions obtiene los parámetros de paginación de la consulta
+func parseListOptions(c *gin.Context) (models.ListOptions, error) {
+	opts := models.ListOptions{
+		Page:     1,
+		PageSize: models.DefaultPageSize,
+	}
+
+	if value := c.Query("page"); value != "" {
+		page, err := strconv.ParseInt(value, 10, 64)
+		if err != nil || page < 1 {
+			return models.ListOptions{}, apierror.NewError(apierror.BadRequest, "el parámetro page debe ser un entero mayor o igual a 1")
+		}
+		opts.Page = page
+	}
+
+	if value := c.Query("pageSize"); value != "" {
+		pageSize, err := strconv.ParseInt(value, 10, 64)
+		if err != nil || pageSize < 1 || pageSize > models.MaxPageSize {
+			return models.ListOptions{}, apierror.NewError(apierror.BadRequest, "el parámetro pageSize debe ser un entero entre 1 y "+strconv.FormatInt(models.MaxPageSize, 10))
+		}
+		opts.PageSize = pageSize
+	}
+
+	sort, err := parseSort(c.Query("sort"))
+	if err != nil {
+		return models.ListOptions{}, err
+	}
+	opts.Sort = sort
+
+	if value := c.Query("cursor"); value != "" {
+		if c.Query("page") != "" || len(opts.Sort) > 0 {
+			return models.ListOptions{}, apierror.NewError(apierror.BadRequest, "el parámetro cursor no se puede combinar con page ni sort")
+		}
+
+		cursor, err := models.DecodeEventCursor(value)
+		if err != nil {
+			return models.ListOptions{}, apierror.NewError(apierror.BadRequest, err.Error())
+		}
+		opts.Cursor = &cursor
+		opts.Page = 0
+	}
+
+	return opts, nil
+}
+
+// parseSort interpreta una lista de campos separados por comas, donde el prefijo "-"
+// indica orden descendente (por ejemplo "-date,name")
+func parseSort(value string) ([]models.SortField, error) {
+	if value == "" {
+		return nil, nil
+	}
+
+	var sort []models.SortField
+	seen := make(map[string]bool)
+	for _, part := range strings.Split(value, ",") {
+		part = strings.TrimSpace(part)
+		descending := strings.HasPrefix(part, "-")
+		name := strings.TrimPrefix(strings.TrimPrefix(part, "-"), "+")
+
+		field, ok := models.SortableEventFields[name]
+		if !ok {
+			return nil, apierror.NewError(apierror.BadRequest, "campo de ordenación no permitido: "+name)
+		}
+
+		if seen[field] {
+			return nil, apierror.NewError(apierror.BadRequest, "campo de ordenación repetido: "+name)
+		}
+		seen[field] = true
+
+		sort = append(sort, models.SortField{Field: field, Descending: descending})
+	}
+
+	return sort, nil
+}
+
+// parseEventFilter obtiene los criterios de filtrado de eventos de la consulta
+func parseEventFilter(c *gin.Context) (models.EventFilter, error) {
+	var filter models.EventFilter
+
+	for _, value := range queryValues(c, "type") {
+		filter.Types = append(filter.Types, models.EventType(strings.ToUpper(value)))
+	}
+
+	for _, value := range queryValues(c, "status") {
+		filter.Statuses = append(filter.Statuses, models.EventStatus(strings.ToUpper(value)))
+	}
+
+	for _, value := range queryValues(c, "managementStatus") {
+		filter.ManagementStatuses = append(filter.ManagementStatuses, models.ManagementStatus(strings.ToUpper(value)))
+	}
+
+	filter.AssigneeIDs = queryValues(c, "assigneeId")
+	filter.TeamIDs = queryValues(c, "teamId")
+
+	for _, value := range queryValues(c, "tag") {
+		filter.Tags = append(filter.Tags, strings.ToLower(value))
+	}
+
+	// Los filtros por atributo usan un parámetro por clave con el prefijo "label." (label.region=eu)
+	for param := range c.Request.URL.Query() {
+		if !strings.HasPrefix(param, labelParamPrefix) {
+			continue
+		}
+
+		key := strings.ToLower(strings.TrimPrefix(param, labelParamPrefix))
+		if !models.LabelKeyPattern.MatchString(key) {
+			return models.EventFilter{}, apierror.NewError(apierror.BadRequest, "clave de atributo no válida en el parámetro "+param)
+		}
+
+		if values := queryValues(c, param); len(values) > 0 {
+			if filter.Labels == nil {
+				filter.Labels = make(map[string][]string)
+			}
+			filter.Labels[key] = append(filter.Labels[key], values...)
+		}
+	}
+
+	// Los filtros por campo personalizado usan un parámetro por ruta con el prefijo "attr."
+	// (attr.window.start=2025-04-08T00:00:00Z)
+	for param := range c.Request.URL.Query() {
+		if !strings.HasPrefix(param, attributeParamPrefix) {
+			continue
+		}
+
+		// La ruta se valida en el servicio junto al resto del filtro
+		path := strings.TrimPrefix(param, attributeParamPrefix)
+		if values := queryValues(c, param); len(values) > 0 {
+			if filter.Attributes == nil {
+				filter.Attributes = make(map[string][]string)
+			}
+			filter.Attributes[path] = append(filter.Attributes[path], values...)
+		}
+	}
+
+	if value := c.Query("unassigned"); value != "" {
+		unassigned, err := strconv.ParseBool(value)
+		if err != nil {
+			return models.EventFilter{}, apierror.NewError(apierror.BadRequest, "el parámetro unassigned debe ser true o false")
+		}
+		filter.Unassigned = unassigned
+	}
+
+	dateParams := []struct {
+		name  string
+		dest  **time.Time
+		upper bool
+	}{
+		{"dateFrom", &filter.DateFrom, false},
+		{"dateTo", &filter.DateTo, true},
+		{"createdFrom", &filter.CreatedFrom, false},
+		{"createdTo", &filter.CreatedTo, true},
+	}
+
+	for _, param := range dateParams {
+		value := c.Query(param.name)
+		if value == "" {
+			continue
+		}
+
+		date, err := parseQueryDate(value, param.upper)
+		if err != nil {
+			return models.EventFilter{}, apierror.NewError(apierror.BadRequest, "el parámetro "+param.name+" debe tener formato RFC3339 o YYYY-MM-DD")
+		}
+		*param.dest = &date
+	}
+
+	return filter, nil
+}
+
+// parseStatsOptions obtiene las opciones de cálculo de estadísticas de la consulta
+func parseStatsOptions(c *gin.Context) models.EventStatsOptions {
+	var opts models.EventStatsOptions
+
+	for _, value := range queryValues(c, "groupBy") {
+		opts.GroupBy = append(opts.GroupBy, models.StatsGroup(value))
+	}
+	opts.Interval = models.StatsInterval(strings.ToLower(c.Query("interval")))
+	opts.DateField = models.StatsDateField(c.Query("dateField"))
+
+	return opts
+}
+
+// queryValues obtiene todos los valores de un parámetro, admitiendo repeticiones y listas separadas por comas
+func queryValues(c *gin.Context, key string) []string {
+	var values []string
+	for _, raw := range c.QueryArray(key) {
+		for _, value := range strings.Split(raw, ",") {
+			if value = strings.TrimSpace(value); value != "" {
+				values = append(values, value)
+			}
+		}
+	}
+	return values
+}
+
+// parseQueryDate interpreta una fecha en formato RFC3339 o YYYY-MM-DD.
+// Si la fecha no incluye hora y es un límite superior, se toma el final del día.
+func parseQueryDate(value string, upper bool) (time.Time, error) {
+	if date, err := time.Parse(time.RFC3339, value); err == nil {
+		return date, nil
+	}
+
+	date, err := time.Parse("2006-01-02", value)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	if upper {
+		date = date.Add(24*time.Hour - time.Nanosecond)
+	}
+	return date, nil
+}
+
+// setPaginationLinks completa los enlaces a la página siguiente y anterior
+func setPaginationLinks(c *gin.Context, pagination *models.Pagination) {
+	// En la paginación por cursor solo existe enlace a la página siguiente
+	if pagination.Page == 0 {
+		if pagination.NextCursor != "" {
+			pagination.Next = cursorLink(c, pagination.NextCursor, pagination.PageSize)
+		}
+		return
+	}
+
+	if pagination.HasNext() {
+		pagination.Next = pageLink(c, pagination.Page+1, pagination.PageSize)
+	}
+
+	if pagination.HasPrev() {
+		prev := pagination.Page - 1
+		// Si la página actual está fuera de rango, el enlace apunta a la última página
+		if pagination.TotalPages > 0 && prev > pagination.TotalPages {
+			prev = pagination.TotalPages
+		}
+		pagination.Prev = pageLink(c, prev, pagination.PageSize)
+	}
+}
+
+// pageLink construye el enlace a una página conservando el resto de la consulta
+func pageLink(c *gin.Context, page, pageSize int64) string {
+	query := c.Request.URL.Query()
+	query.Set("page", strconv.FormatInt(page, 10))
+	query.Set("pageSize", strconv.FormatInt(pageSize, 10))
+
+	link := url.URL{
+		Path:     c.Request.URL.Path,
+		RawQuery: query.Encode(),
+	}
+	return link.String()
+}
+
+// cursorLink construye el enlace a la página que comienza tras el cursor indicado
+func cursorLink(c *gin.Context, cursor string, pageSize int64) string {
+	query := c.Request.URL.Query()
+	query.Del("page")
+	query.Set("cursor", cursor)
+	query.Set("pageSize", strconv.FormatInt(pageSize, 10))
+
+	link := url.URL{
+		Path:     c.Request.URL.Path,
+		RawQuery: query.Encode(),
+	}
+	return link.String()
+}
@@ -0,0 +1,170 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"events-api/internal/apierror"
+	"events-api/internal/models"
+	"events-api/internal/services"
+)
+
+// RuleHandler maneja las solicitudes HTTP relacionadas con las reglas de gestión
+type RuleHandler struct {
+	service services.RuleService
+}
+
+// NewRuleHandler crea una nueva instancia de RuleHandler
+func NewRuleHandler(service services.RuleService) *RuleHandler {
+	return &RuleHandler{
+		service: service,
+	}
+}
+
+// CreateRule godoc
+//
+//	@Summary		Crear una regla de gestión
+//	@Description	Crea una regla que asigna automáticamente el estado de gestión al revisar eventos
+//	@Tags			rules
+//	@Accept			json
+//	@Produce		json
+//	@Param			rule	body		models.CreateRuleRequest	true	"Información de la regla"
+//	@Success		201		{object}	models.ManagementRule
+//	@Failure		400		{object}	models.ErrorResponse	"Error en los datos de entrada"
+//	@Failure		409		{object}	models.ErrorResponse	"Ya existe una regla predeterminada"
+//	@Failure		500		{object}	models.ErrorResponse	"Error interno del servidor"
+//	@Router			/rules [post]
+func (h *RuleHandler) CreateRule(c *gin.Context) {
+	var req models.CreateRuleRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	rule, err := h.service.CreateRule(c.Request.Context(), req)
+	if err != nil {
+		if apiErr, ok := apierror.AsError(err); ok {
+			c.JSON(apiErr.Status(), gin.H{"error": apiErr.Error()})
+		} else {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		}
+		return
+	}
+
+	c.JSON(http.StatusCreated, rule)
+}
+
+// GetAllRules godoc
+//
+//	@Summary		Obtener las reglas de gestión
+//	@Description	Obtiene todas las reglas de gestión en orden de evaluación
+//	@Tags			rules
+//	@Produce		json
+//	@Success		200	{array}		models.ManagementRule
+//	@Failure		500	{object}	models.ErrorResponse	"Error interno del servidor"
+//	@Router			/rules [get]
+func (h *RuleHandler) GetAllRules(c *gin.Context) {
+	rules, err := h.service.GetAllRules(c.Request.Context())
+	if err != nil {
+		if apiErr, ok := apierror.AsError(err); ok {
+			c.JSON(apiErr.Status(), gin.H{"error": apiErr.Error()})
+		} else {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		}
+		return
+	}
+
+	if rules == nil {
+		rules = []models.ManagementRule{}
+	}
+
+	c.JSON(http.StatusOK, rules)
+}
+
+// GetRuleByID godoc
+//
+//	@Summary		Obtener una regla de gestión por ID
+//	@Description	Obtiene una regla de gestión por su ID
+//	@Tags			rules
+//	@Produce		json
+//	@Param			id	path		string	true	"ID de la regla"
+//	@Success		200	{object}	models.ManagementRule
+//	@Failure		404	{object}	models.ErrorResponse	"Regla no encontrada"
+//	@Failure		500	{object}	models.ErrorResponse	"Error interno del servidor"
+//	@Router			/rules/{id} [get]
+func (h *RuleHandler) GetRuleByID(c *gin.Context) {
+	id := c.Param("id")
+	rule, err := h.service.GetRuleByID(c.Request.Context(), id)
+	if err != nil {
+		if apiErr, ok := apierror.AsError(err); ok {
+			c.JSON(apiErr.Status(), gin.H{"error": apiErr.Error()})
+		} else {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, rule)
+}
+
+// UpdateRule godoc
+//
+//	@Summary		Actualizar una regla de gestión
+//	@Description	Actualiza los campos proporcionados de una regla de gestión
+//	@Tags			rules
+//	@Accept			json
+//	@Produce		json
+//	@Param			id		path		string						true	"ID de la regla"
+//	@Param			rule	body		models.UpdateRuleRequest	true	"Campos a actualizar"
+//	@Success		200		{object}	models.ManagementRule
+//	@Failure		400		{object}	models.ErrorResponse	"Error en los datos de entrada"
+//	@Failure		404		{object}	models.ErrorResponse	"Regla no encontrada"
+//	@Failure		409		{object}	models.ErrorResponse	"Ya existe una regla predeterminada"
+//	@Failure		500		{object}	models.ErrorResponse	"Error interno del servidor"
+//	@Router			/rules/{id} [put]
+func (h *RuleHandler) UpdateRule(c *gin.Context) {
+	id := c.Param("id")
+	var req models.UpdateRuleRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "error en el formato de datos: " + err.Error()})
+		return
+	}
+
+	rule, err := h.service.UpdateRule(c.Request.Context(), id, req)
+	if err != nil {
+		if apiErr, ok := apierror.AsError(err); ok {
+			c.JSON(apiErr.Status(), gin.H{"error": apiErr.Error()})
+		} else {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, rule)
+}
+
+// DeleteRule godoc
+//
+//	@Summary		Eliminar una regla de gestión
+//	@Description	Elimina una regla de gestión existente
+//	@Tags			rules
+//	@Param			id	path		string	true	"ID de la regla"
+//	@Success		204	{object}	nil
+//	@Failure		404	{object}	models.ErrorResponse	"Regla no encontrada"
+//	@Failure		500	{object}	models.ErrorResponse	"Error interno del servidor"
+//	@Router			/rules/{id} [delete]
+func (h *RuleHandler) DeleteRule(c *gin.Context) {
+	id := c.Param("id")
+	err := h.service.DeleteRule(c.Request.Context(), id)
+	if err != nil {
+		if apiErr, ok := apierror.AsError(err); ok {
+			c.JSON(apiErr.Status(), gin.H{"error": apiErr.Error()})
+		} else {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		}
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
@@ -0,0 +1,132 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"events-api/internal/apierror"
+	"events-api/internal/models"
+	"events-api/internal/services"
+)
+
+// SLAHandler maneja las solicitudes HTTP relacionadas con las políticas de SLA
+type SLAHandler struct {
+	service services.SLAService
+}
+
+// NewSLAHandler crea una nueva instancia de SLAHandler
+func NewSLAHandler(service services.SLAService) *SLAHandler {
+	return &SLAHandler{
+		service: service,
+	}
+}
+
+// GetAllPolicies godoc
+//
+//	@Summary		Obtener las políticas de SLA
+//	@Description	Obtiene la política vigente de cada tipo de evento: la configurada o, en su ausencia, la predeterminada
+//	@Tags			sla
+//	@Produce		json
+//	@Success		200	{array}		models.SLAPolicy
+//	@Failure		500	{object}	models.ErrorResponse	"Error interno del servidor"
+//	@Router			/sla-policies [get]
+func (h *SLAHandler) GetAllPolicies(c *gin.Context) {
+	policies, err := h.service.GetPolicies(c.Request.Context())
+	if err != nil {
+		if apiErr, ok := apierror.AsError(err); ok {
+			c.JSON(apiErr.Status(), gin.H{"error": apiErr.Error()})
+		} else {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, policies)
+}
+
+// GetPolicy godoc
+//
+//	@Summary		Obtener la política de SLA de un tipo de evento
+//	@Description	Obtiene la política vigente de un tipo de evento
+//	@Tags			sla
+//	@Produce		json
+//	@Param			type	path		string	true	"Tipo de evento"
+//	@Success		200		{object}	models.SLAPolicy
+//	@Failure		400		{object}	models.ErrorResponse	"Tipo de evento no válido"
+//	@Failure		500		{object}	models.ErrorResponse	"Error interno del servidor"
+//	@Router			/sla-policies/{type} [get]
+func (h *SLAHandler) GetPolicy(c *gin.Context) {
+	eventType := models.EventType(c.Param("type"))
+	policy, err := h.service.GetPolicy(c.Request.Context(), eventType)
+	if err != nil {
+		if apiErr, ok := apierror.AsError(err); ok {
+			c.JSON(apiErr.Status(), gin.H{"error": apiErr.Error()})
+		} else {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, policy)
+}
+
+// UpdatePolicy godoc
+//
+//	@Summary		Configurar la política de SLA de un tipo de evento
+//	@Description	Configura los plazos de revisión y de resolución de un tipo de evento, en minutos. Un plazo de 0 indica que la fase no tiene plazo. Los nuevos plazos se aplican a los eventos que se creen o revisen a partir de ese momento.
+//	@Tags			sla
+//	@Accept			json
+//	@Produce		json
+//	@Param			type	path		string							true	"Tipo de evento"
+//	@Param			policy	body		models.UpdateSLAPolicyRequest	true	"Plazos del tipo de evento"
+//	@Success		200		{object}	models.SLAPolicy
+//	@Failure		400		{object}	models.ErrorResponse	"Error en los datos de entrada"
+//	@Failure		500		{object}	models.ErrorResponse	"Error interno del servidor"
+//	@Router			/sla-policies/{type} [put]
+func (h *SLAHandler) UpdatePolicy(c *gin.Context) {
+	eventType := models.EventType(c.Param("type"))
+	var req models.UpdateSLAPolicyRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "error en el formato de datos: " + err.Error()})
+		return
+	}
+
+	policy, err := h.service.UpdatePolicy(c.Request.Context(), eventType, req)
+	if err != nil {
+		if apiErr, ok := apierror.AsError(err); ok {
+			c.JSON(apiErr.Status(), gin.H{"error": apiErr.Error()})
+		} else {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, policy)
+}
+
+// DeletePolicy godoc
+//
+//	@Summary		Restablecer la política de SLA de un tipo de evento
+//	@Description	Elimina la política configurada de un tipo de evento, que vuelve a usar los plazos predeterminados
+//	@Tags			sla
+//	@Param			type	path		string	true	"Tipo de evento"
+//	@Success		204		{object}	nil
+//	@Failure		400		{object}	models.ErrorResponse	"Tipo de evento no válido"
+//	@Failure		404		{object}	models.ErrorResponse	"El tipo de evento no tiene una política configurada"
+//	@Failure		500		{object}	models.ErrorResponse	"Error interno del servidor"
+//	@Router			/sla-policies/{type} [delete]
+func (h *SLAHandler) DeletePolicy(c *gin.Context) {
+	eventType := models.EventType(c.Param("type"))
+	err := h.service.DeletePolicy(c.Request.Context(), eventType)
+	if err != nil {
+		if apiErr, ok := apierror.AsError(err); ok {
+			c.JSON(apiErr.Status(), gin.H{"error": apiErr.Error()})
+		} else {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		}
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
@@ -0,0 +1,168 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"events-api/internal/apierror"
+	"events-api/internal/models"
+	"events-api/internal/services"
+)
+
+// TeamHandler maneja las solicitudes HTTP relacionadas con los equipos
+type TeamHandler struct {
+	service services.TeamService
+}
+
+// NewTeamHandler crea una nueva instancia de TeamHandler
+func NewTeamHandler(service services.TeamService) *TeamHandler {
+	return &TeamHandler{
+		service: service,
+	}
+}
+
+// CreateTeam godoc
+//
+//	@Summary		Crear un equipo
+//	@Description	Crea un equipo cuyos miembros reciben por turnos los eventos que requieren gestión
+//	@Tags			teams
+//	@Accept			json
+//	@Produce		json
+//	@Param			team	body		models.CreateTeamRequest	true	"Información del equipo"
+//	@Success		201		{object}	models.Team
+//	@Failure		400		{object}	models.ErrorResponse	"Error en los datos de entrada"
+//	@Failure		500		{object}	models.ErrorResponse	"Error interno del servidor"
+//	@Router			/teams [post]
+func (h *TeamHandler) CreateTeam(c *gin.Context) {
+	var req models.CreateTeamRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	team, err := h.service.CreateTeam(c.Request.Context(), req)
+	if err != nil {
+		if apiErr, ok := apierror.AsError(err); ok {
+			c.JSON(apiErr.Status(), gin.H{"error": apiErr.Error()})
+		} else {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		}
+		return
+	}
+
+	c.JSON(http.StatusCreated, team)
+}
+
+// GetAllTeams godoc
+//
+//	@Summary		Obtener los equipos
+//	@Description	Obtiene todos los equipos por orden de creación
+//	@Tags			teams
+//	@Produce		json
+//	@Success		200	{array}		models.Team
+//	@Failure		500	{object}	models.ErrorResponse	"Error interno del servidor"
+//	@Router			/teams [get]
+func (h *TeamHandler) GetAllTeams(c *gin.Context) {
+	teams, err := h.service.GetAllTeams(c.Request.Context())
+	if err != nil {
+		if apiErr, ok := apierror.AsError(err); ok {
+			c.JSON(apiErr.Status(), gin.H{"error": apiErr.Error()})
+		} else {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		}
+		return
+	}
+
+	if teams == nil {
+		teams = []models.Team{}
+	}
+
+	c.JSON(http.StatusOK, teams)
+}
+
+// GetTeamByID godoc
+//
+//	@Summary		Obtener un equipo por ID
+//	@Description	Obtiene un equipo por su ID
+//	@Tags			teams
+//	@Produce		json
+//	@Param			id	path		string	true	"ID del equipo"
+//	@Success		200	{object}	models.Team
+//	@Failure		404	{object}	models.ErrorResponse	"Equipo no encontrado"
+//	@Failure		500	{object}	models.ErrorResponse	"Error interno del servidor"
+//	@Router			/teams/{id} [get]
+func (h *TeamHandler) GetTeamByID(c *gin.Context) {
+	id := c.Param("id")
+	team, err := h.service.GetTeamByID(c.Request.Context(), id)
+	if err != nil {
+		if apiErr, ok := apierror.AsError(err); ok {
+			c.JSON(apiErr.Status(), gin.H{"error": apiErr.Error()})
+		} else {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, team)
+}
+
+// UpdateTeam godoc
+//
+//	@Summary		Actualizar un equipo
+//	@Description	Actualiza los campos proporcionados de un equipo
+//	@Tags			teams
+//	@Accept			json
+//	@Produce		json
+//	@Param			id		path		string						true	"ID del equipo"
+//	@Param			team	body		models.UpdateTeamRequest	true	"Campos a actualizar"
+//	@Success		200		{object}	models.Team
+//	@Failure		400		{object}	models.ErrorResponse	"Error en los datos de entrada"
+//	@Failure		404		{object}	models.ErrorResponse	"Equipo no encontrado"
+//	@Failure		500		{object}	models.ErrorResponse	"Error interno del servidor"
+//	@Router			/teams/{id} [put]
+func (h *TeamHandler) UpdateTeam(c *gin.Context) {
+	id := c.Param("id")
+	var req models.UpdateTeamRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "error en el formato de datos: " + err.Error()})
+		return
+	}
+
+	team, err := h.service.UpdateTeam(c.Request.Context(), id, req)
+	if err != nil {
+		if apiErr, ok := apierror.AsError(err); ok {
+			c.JSON(apiErr.Status(), gin.H{"error": apiErr.Error()})
+		} else {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, team)
+}
+
+// DeleteTeam godoc
+//
+//	@Summary		Eliminar un equipo
+//	@Description	Elimina un equipo existente; los eventos asignados conservan su responsable
+//	@Tags			teams
+//	@Param			id	path		string	true	"ID del equipo"
+//	@Success		204	{object}	nil
+//	@Failure		404	{object}	models.ErrorResponse	"Equipo no encontrado"
+//	@Failure		500	{object}	models.ErrorResponse	"Error interno del servidor"
+//	@Router			/teams/{id} [delete]
+func (h *TeamHandler) DeleteTeam(c *gin.Context) {
+	id := c.Param("id")
+	err := h.service.DeleteTeam(c.Request.Context(), id)
+	if err != nil {
+		if apiErr, ok := apierror.AsError(err); ok {
+			c.JSON(apiErr.Status(), gin.H{"error": apiErr.Error()})
+		} else {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		}
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
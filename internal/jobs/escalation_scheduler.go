@@ -0,0 +1,33 @@
+package jobs
+
+import (
+	"context"
+	"log"
+
+	"events-api/internal/config"
+	"events-api/internal/services"
+)
+
+// EscalationScheduler escala los eventos sin atender según las políticas de escalado de su tipo
+type EscalationScheduler struct {
+	*periodicJob
+}
+
+// NewEscalationScheduler crea una nueva instancia de EscalationScheduler
+func NewEscalationScheduler(service services.EventService, cfg *config.Config) *EscalationScheduler {
+	run := func(ctx context.Context) error {
+		escalated, err := service.EscalateEvents(ctx)
+		if err != nil {
+			return err
+		}
+
+		if escalated > 0 {
+			log.Printf("Escalado de eventos: %d eventos escalados\n", escalated)
+		}
+		return nil
+	}
+
+	return &EscalationScheduler{
+		periodicJob: newPeriodicJob("escalado de eventos", cfg.EscalationInterval, run),
+	}
+}
@@ -0,0 +1,68 @@
+package jobs
+
+import (
+	"context"
+	"log"
+	"time"
+)
+
+// periodicJob ejecuta una tarea en segundo plano a intervalos regulares
+type periodicJob struct {
+	name     string
+	interval time.Duration
+	run      func(ctx context.Context) error
+	cancel   context.CancelFunc
+	done     chan struct{}
+}
+
+// newPeriodicJob crea una tarea periódica con el nombre, intervalo y función indicados
+func newPeriodicJob(name string, interval time.Duration, run func(ctx context.Context) error) *periodicJob {
+	return &periodicJob{
+		name:     name,
+		interval: interval,
+		run:      run,
+	}
+}
+
+// Start inicia la ejecución periódica de la tarea en una goroutine
+func (j *periodicJob) Start() {
+	ctx, cancel := context.WithCancel(context.Background())
+	j.cancel = cancel
+	j.done = make(chan struct{})
+
+	go func() {
+		defer close(j.done)
+
+		ticker := time.NewTicker(j.interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				if err := j.run(ctx); err != nil && ctx.Err() == nil {
+					log.Printf("Error en la tarea %s: %v\n", j.name, err)
+				}
+			}
+		}
+	}()
+
+	log.Printf("Tarea %s iniciada (intervalo %s)\n", j.name, j.interval)
+}
+
+// Stop detiene la tarea y espera a que termine la ejecución en curso o a que expire el contexto
+func (j *periodicJob) Stop(ctx context.Context) error {
+	if j.cancel == nil {
+		return nil
+	}
+
+	j.cancel()
+
+	select {
+	case <-j.done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
@@ -0,0 +1,33 @@
+package jobs
+
+import (
+	"context"
+	"log"
+
+	"events-api/internal/config"
+	"events-api/internal/services"
+)
+
+// SLAChecker marca los eventos cuyo plazo de revisión o de resolución ha vencido
+type SLAChecker struct {
+	*periodicJob
+}
+
+// NewSLAChecker crea una nueva instancia de SLAChecker
+func NewSLAChecker(service services.EventService, cfg *config.Config) *SLAChecker {
+	run := func(ctx context.Context) error {
+		flagged, err := service.FlagSLABreaches(ctx)
+		if err != nil {
+			return err
+		}
+
+		if flagged > 0 {
+			log.Printf("Comprobación de SLA: %d eventos han incumplido su plazo\n", flagged)
+		}
+		return nil
+	}
+
+	return &SLAChecker{
+		periodicJob: newPeriodicJob("comprobación de SLA", cfg.SLACheckInterval, run),
+	}
+}
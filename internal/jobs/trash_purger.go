@@ -0,0 +1,37 @@
+package jobs
+
+import (
+	"context"
+	"log"
+
+	"events-api/internal/actor"
+	"events-api/internal/config"
+	"events-api/internal/services"
+)
+
+// TrashPurger elimina definitivamente los eventos que superan el tiempo de retención en la papelera
+type TrashPurger struct {
+	*periodicJob
+}
+
+// NewTrashPurger crea una nueva instancia de TrashPurger
+func NewTrashPurger(service services.EventService, cfg *config.Config) *TrashPurger {
+	run := func(ctx context.Context) error {
+		// La purga se registra en el historial de cada evento con el usuario del sistema
+		ctx = actor.WithID(ctx, actor.System)
+
+		purged, err := service.PurgeDeletedEvents(ctx, cfg.TrashRetention)
+		if err != nil {
+			return err
+		}
+
+		if purged > 0 {
+			log.Printf("Purga de la papelera: %d eventos eliminados definitivamente\n", purged)
+		}
+		return nil
+	}
+
+	return &TrashPurger{
+		periodicJob: newPeriodicJob("purga de la papelera", cfg.PurgeInterval, run),
+	}
+}
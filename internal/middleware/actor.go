@@ -0,0 +1,23 @@
+package middleware
+
+import (
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	"events-api/internal/actor"
+)
+
+// ActorHeader es la cabecera que identifica al usuario que realiza la solicitud
+const ActorHeader = "X-User-ID"
+
+// Actor es un middleware que propaga el usuario de la solicitud en su contexto
+func Actor() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if id := strings.TrimSpace(c.GetHeader(ActorHeader)); id != "" {
+			c.Request = c.Request.WithContext(actor.WithID(c.Request.Context(), id))
+		}
+
+		c.Next()
+	}
+}
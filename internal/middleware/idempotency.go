@@ -0,0 +1,172 @@
+package middleware
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"log"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"events-api/internal/actor"
+	"events-api/internal/apierror"
+	"events-api/internal/models"
+	"events-api/internal/repositories"
+)
+
+const (
+	// IdempotencyKeyHeader es la cabecera con la que el cliente identifica una solicitud reintentable
+	IdempotencyKeyHeader = "Idempotency-Key"
+	// IdempotentReplayedHeader indica que la respuesta se ha reproducido a partir de una clave almacenada
+	IdempotentReplayedHeader = "Idempotent-Replayed"
+
+	maxIdempotencyKeyLength = 255
+)
+
+// idempotencyReplayHeaders son las cabeceras de la respuesta original que se reproducen
+var idempotencyReplayHeaders = []string{"Content-Type", "ETag", "Location"}
+
+// Idempotency es un middleware que evita procesar dos veces la misma solicitud. La primera
+// respuesta para una clave se almacena y los reintentos con la misma clave y el mismo cuerpo
+// la reproducen; si el cuerpo es distinto se responde 422. Las claves se asocian al usuario,
+// al método y a la ruta, y las solicitudes sin la cabecera Idempotency-Key no se ven afectadas.
+func Idempotency(repository repositories.IdempotencyRepository) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		key := strings.TrimSpace(c.GetHeader(IdempotencyKeyHeader))
+		if key == "" {
+			c.Next()
+			return
+		}
+
+		if len(key) > maxIdempotencyKeyLength {
+			abortWithError(c, apierror.NewError(apierror.BadRequest, "la cabecera Idempotency-Key no puede superar 255 caracteres"))
+			return
+		}
+
+		body, err := io.ReadAll(c.Request.Body)
+		if err != nil {
+			abortWithError(c, apierror.NewError(apierror.BadRequest, "error al leer la solicitud: "+err.Error()))
+			return
+		}
+		c.Request.Body = io.NopCloser(bytes.NewReader(body))
+
+		hash := sha256.Sum256(body)
+		record := models.IdempotencyRecord{
+			Key:         key,
+			Actor:       actor.FromContext(c.Request.Context()),
+			Method:      c.Request.Method,
+			Path:        c.Request.URL.Path,
+			RequestHash: hex.EncodeToString(hash[:]),
+		}
+		record.ID = record.Actor + " " + record.Method + " " + record.Path + " " + record.Key
+
+		stored, reserved, err := repository.Reserve(c.Request.Context(), record)
+		if err != nil {
+			abortWithError(c, err)
+			return
+		}
+
+		if !reserved {
+			replayIdempotentResponse(c, record, stored)
+			return
+		}
+
+		writer := &idempotencyWriter{ResponseWriter: c.Writer}
+		c.Writer = writer
+
+		// Si no se almacena la respuesta, porque es un error del servidor, porque falla el
+		// almacenamiento o porque el handler entra en pánico, la clave se libera para que el
+		// cliente pueda reintentar
+		completed := false
+		defer func() {
+			if completed {
+				return
+			}
+
+			ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancel()
+
+			if err := repository.Release(ctx, record.ID); err != nil {
+				log.Printf("Error al liberar la clave de idempotencia %q: %v\n", key, err)
+			}
+		}()
+
+		c.Next()
+
+		// La respuesta se almacena aunque el cliente haya cancelado la solicitud
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+
+		status := writer.Status()
+		if status >= http.StatusInternalServerError {
+			return
+		}
+
+		headers := make(map[string]string)
+		for _, name := range idempotencyReplayHeaders {
+			if value := writer.Header().Get(name); value != "" {
+				headers[name] = value
+			}
+		}
+
+		if err := repository.Complete(ctx, record.ID, status, headers, writer.body.Bytes()); err != nil {
+			log.Printf("Error al almacenar la respuesta de la clave de idempotencia %q: %v\n", key, err)
+			return
+		}
+		completed = true
+	}
+}
+
+// replayIdempotentResponse responde a una solicitud cuya clave ya estaba registrada
+func replayIdempotentResponse(c *gin.Context, record, stored models.IdempotencyRecord) {
+	if stored.RequestHash != record.RequestHash {
+		abortWithError(c, apierror.NewError(apierror.Unprocessable, "la clave de idempotencia ya se usó con una solicitud distinta"))
+		return
+	}
+
+	if !stored.Completed {
+		abortWithError(c, apierror.NewError(apierror.ResourceExists, "la solicitud con esta clave de idempotencia todavía se está procesando"))
+		return
+	}
+
+	for name, value := range stored.Headers {
+		c.Header(name, value)
+	}
+	c.Header(IdempotentReplayedHeader, "true")
+
+	c.Status(stored.StatusCode)
+	c.Writer.Write(stored.Body)
+	c.Abort()
+}
+
+// abortWithError interrumpe la solicitud respondiendo con el error indicado
+func abortWithError(c *gin.Context, err error) {
+	if apiErr, ok := apierror.AsError(err); ok {
+		c.AbortWithStatusJSON(apiErr.Status(), gin.H{"error": apiErr.Error()})
+	} else {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+	}
+}
+
+// idempotencyWriter copia el cuerpo de la respuesta mientras se escribe
+type idempotencyWriter struct {
+	gin.ResponseWriter
+	body bytes.Buffer
+}
+
+// Write escribe el cuerpo de la respuesta y conserva una copia
+func (w *idempotencyWriter) Write(data []byte) (int, error) {
+	w.body.Write(data)
+	return w.ResponseWriter.Write(data)
+}
+
+// WriteString escribe el cuerpo de la respuesta y conserva una copia
+func (w *idempotencyWriter) WriteString(s string) (int, error) {
+	w.body.WriteString(s)
+	return w.ResponseWriter.WriteString(s)
+}
@@ -0,0 +1,24 @@
+package models
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// MaxAttachmentsPerEvent es el número máximo de archivos adjuntos de un evento
+const MaxAttachmentsPerEvent = 50
+
+// Attachment representa los metadatos de un archivo adjunto a un evento. El contenido se guarda
+// en el almacén de archivos con la clave indicada en StorageKey.
+type Attachment struct {
+	ID          primitive.ObjectID `json:"id" bson:"_id"`
+	FileName    string             `json:"fileName" bson:"file_name" example:"captura.png"`
+	ContentType string             `json:"contentType" bson:"content_type" example:"image/png"`
+	Size        int64              `json:"size" bson:"size" example:"48213"`
+	// Checksum es el resumen SHA-256 del contenido en hexadecimal
+	Checksum   string    `json:"checksum" bson:"checksum" example:"9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08"`
+	StorageKey string    `json:"-" bson:"storage_key"`
+	UploadedBy string    `json:"uploadedBy" bson:"uploaded_by" example:"ana"`
+	UploadedAt time.Time `json:"uploadedAt" bson:"uploaded_at"`
+}
@@ -0,0 +1,14 @@
+package models
+
+import "regexp"
+
+// Límites de los campos personalizados de un evento y del esquema que los valida
+const (
+	MaxAttributesSize       = 16 << 10
+	MaxAttributesSchemaSize = 64 << 10
+	MaxAttributePathDepth   = 8
+)
+
+// AttributePathPattern es el formato de la ruta de un campo personalizado en los filtros: claves
+// separadas por puntos, sin caracteres reservados de MongoDB
+var AttributePathPattern = regexp.MustCompile(`^[A-Za-z0-9_-]+(\.[A-Za-z0-9_-]+)*$`)
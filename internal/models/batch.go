@@ -0,0 +1,29 @@
+package models
+
+import (
+	"encoding/json"
+
+	"events-api/internal/apierror"
+)
+
+// BatchEventRequest representa un lote de eventos a crear. Cada elemento tiene el formato de
+// CreateEventRequest y se valida por separado.
+type BatchEventRequest struct {
+	Events []json.RawMessage `json:"events" swaggertype:"array,object"`
+}
+
+// BatchEventResult representa el resultado de la creación de un evento del lote
+type BatchEventResult struct {
+	Index   int             `json:"index" example:"0"`
+	ID      string          `json:"id,omitempty" example:"6512bd43d9caa6e02c990b0a"`
+	Success bool            `json:"success" example:"true"`
+	Error   *apierror.Error `json:"error,omitempty"`
+}
+
+// BatchEventResponse representa el resultado de la creación de un lote de eventos
+type BatchEventResponse struct {
+	Total   int                `json:"total" example:"2"`
+	Created int                `json:"created" example:"1"`
+	Failed  int                `json:"failed" example:"1"`
+	Results []BatchEventResult `json:"results"`
+}
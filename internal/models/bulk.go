@@ -0,0 +1,63 @@
+package models
+
+import "events-api/internal/apierror"
+
+// BulkActionType es un tipo para representar la acción de una operación masiva
+type BulkActionType string
+
+const (
+	// Acciones de las operaciones masivas
+	BulkReview   BulkActionType = "REVIEW"
+	BulkUnreview BulkActionType = "UNREVIEW"
+	BulkDelete   BulkActionType = "DELETE"
+	BulkRetype   BulkActionType = "RETYPE"
+
+	// MaxBulkOperations es el número máximo de eventos afectados por una operación masiva
+	MaxBulkOperations = 1000
+)
+
+// BulkAction representa la acción a aplicar sobre un evento. EventType solo se usa en RETYPE,
+// y ManagementStatus y Justification en las revisiones manuales.
+type BulkAction struct {
+	Action           BulkActionType   `json:"action" example:"REVIEW"`
+	EventType        EventType        `json:"eventType,omitempty" example:"ALERT"`
+	ManagementStatus ManagementStatus `json:"managementStatus,omitempty" example:"REQUIRES_MANAGEMENT"`
+	Justification    string           `json:"justification,omitempty" example:"Revisión masiva tras el incidente"`
+}
+
+// BulkOperation representa una acción sobre un evento concreto. Si se indica Version, el evento
+// debe conservar esa versión.
+type BulkOperation struct {
+	ID      string `json:"id" example:"6512bd43d9caa6e02c990b0a"`
+	Version *int64 `json:"version,omitempty" example:"3"`
+	BulkAction
+}
+
+// BulkEventRequest representa la solicitud de una operación masiva. Se indica una lista de
+// operaciones o bien un filtro junto a la acción a aplicar sobre todos los eventos que lo cumplen.
+// Si Atomic es true, las operaciones se aplican todas o ninguna.
+type BulkEventRequest struct {
+	Operations []BulkOperation `json:"operations,omitempty"`
+	Filter     *EventFilter    `json:"filter,omitempty"`
+	BulkAction
+	Atomic bool `json:"atomic" example:"false"`
+}
+
+// BulkOperationResult representa el resultado de una operación masiva sobre un evento
+type BulkOperationResult struct {
+	Index   int             `json:"index" example:"0"`
+	ID      string          `json:"id" example:"6512bd43d9caa6e02c990b0a"`
+	Action  BulkActionType  `json:"action" example:"REVIEW"`
+	Success bool            `json:"success" example:"true"`
+	Version int64           `json:"version,omitempty" example:"4"`
+	Error   *apierror.Error `json:"error,omitempty"`
+}
+
+// BulkEventResponse representa el resultado de una operación masiva
+type BulkEventResponse struct {
+	Atomic    bool                  `json:"atomic" example:"false"`
+	Total     int                   `json:"total" example:"2"`
+	Succeeded int                   `json:"succeeded" example:"1"`
+	Failed    int                   `json:"failed" example:"1"`
+	Results   []BulkOperationResult `json:"results"`
+}
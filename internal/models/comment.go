@@ -0,0 +1,49 @@
+package models
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// MaxCommentLength es la longitud máxima, en caracteres, del cuerpo de un comentario
+const MaxCommentLength = 10000
+
+// Comment representa un comentario del hilo de discusión de un evento. El cuerpo admite
+// Markdown y las menciones (@usuario) se extraen del cuerpo al crearlo o editarlo.
+type Comment struct {
+	ID       primitive.ObjectID `json:"id" bson:"_id,omitempty"`
+	EventID  primitive.ObjectID `json:"eventId" bson:"event_id"`
+	Author   string             `json:"author" bson:"author" example:"ana"`
+	Body     string             `json:"body" bson:"body" example:"Revisado con @luis: el **corte** afecta solo al CPD norte"`
+	Mentions []string           `json:"mentions" bson:"mentions" example:"luis"`
+	// Edits son las versiones anteriores del cuerpo, de la más antigua a la más reciente
+	Edits []CommentEdit `json:"edits" bson:"edits"`
+	// ArchivedAt indica cuándo se archivó el comentario al enviar su evento a la papelera
+	ArchivedAt *time.Time `json:"-" bson:"archived_at,omitempty"`
+	CreatedAt  time.Time  `json:"createdAt" bson:"created_at"`
+	UpdatedAt  time.Time  `json:"updatedAt" bson:"updated_at"`
+}
+
+// CommentEdit representa una versión anterior del cuerpo de un comentario
+type CommentEdit struct {
+	Body     string    `json:"body" bson:"body" example:"Revisado: el corte afecta al CPD norte"`
+	EditedBy string    `json:"editedBy" bson:"edited_by" example:"ana"`
+	EditedAt time.Time `json:"editedAt" bson:"edited_at"`
+}
+
+// CreateCommentRequest representa la solicitud para publicar un comentario
+type CreateCommentRequest struct {
+	Body string `json:"body" example:"Revisado con @luis: el **corte** afecta solo al CPD norte" binding:"required"`
+}
+
+// UpdateCommentRequest representa la solicitud para editar un comentario
+type UpdateCommentRequest struct {
+	Body string `json:"body" example:"Revisado con @luis y @marta: el corte afecta solo al CPD norte" binding:"required"`
+}
+
+// CommentListResponse representa una página de los comentarios de un evento
+type CommentListResponse struct {
+	Data       []Comment  `json:"data"`
+	Pagination Pagination `json:"pagination"`
+}
@@ -0,0 +1,63 @@
+package models
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// EventCursor representa la posición del último evento visto en un listado
+// ordenado por fecha de creación e ID descendentes
+type EventCursor struct {
+	CreatedAt time.Time
+	ID        primitive.ObjectID
+}
+
+// cursorPayload es la representación serializada de un EventCursor
+type cursorPayload struct {
+	CreatedAt int64  `json:"t"`
+	ID        string `json:"id"`
+}
+
+// NewEventCursor crea un cursor que apunta al evento indicado
+func NewEventCursor(event Event) EventCursor {
+	return EventCursor{
+		CreatedAt: event.CreatedAt,
+		ID:        event.ID,
+	}
+}
+
+// Encode devuelve el cursor como una cadena opaca apta para URLs
+func (c EventCursor) Encode() string {
+	payload, _ := json.Marshal(cursorPayload{
+		CreatedAt: c.CreatedAt.UnixMilli(),
+		ID:        c.ID.Hex(),
+	})
+	return base64.RawURLEncoding.EncodeToString(payload)
+}
+
+// DecodeEventCursor interpreta un cursor generado por Encode
+func DecodeEventCursor(value string) (EventCursor, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(value)
+	if err != nil {
+		return EventCursor{}, errors.New("cursor inválido")
+	}
+
+	var payload cursorPayload
+	if err := json.Unmarshal(raw, &payload); err != nil {
+		return EventCursor{}, errors.New("cursor inválido")
+	}
+
+	id, err := primitive.ObjectIDFromHex(payload.ID)
+	if err != nil {
+		return EventCursor{}, errors.New("cursor inválido")
+	}
+
+	return EventCursor{
+		CreatedAt: time.UnixMilli(payload.CreatedAt).UTC(),
+		ID:        id,
+	}, nil
+}
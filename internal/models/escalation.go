@@ -0,0 +1,64 @@
+package models
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// MaxEscalationLevels es el número máximo de niveles de una cadena de escalado
+const MaxEscalationLevels = 10
+
+// EscalationPolicy representa la cadena de escalado de un tipo de evento. Los eventos sin
+// atender suben de nivel a medida que se cumplen los retrasos de cada nivel.
+type EscalationPolicy struct {
+	ID        primitive.ObjectID `json:"id" bson:"_id,omitempty"`
+	Name      string             `json:"name" bson:"name"`
+	EventType EventType          `json:"eventType" bson:"event_type" example:"EMERGENCY"`
+	Enabled   bool               `json:"enabled" bson:"enabled"`
+	Levels    []EscalationLevel  `json:"levels" bson:"levels"`
+	CreatedAt time.Time          `json:"createdAt" bson:"created_at"`
+	UpdatedAt time.Time          `json:"updatedAt" bson:"updated_at"`
+}
+
+// EscalationLevel representa un nivel de la cadena de escalado
+type EscalationLevel struct {
+	// DelayMinutes es el tiempo sin atender, desde la creación o la reapertura del evento, tras el que se alcanza el nivel
+	DelayMinutes int `json:"delayMinutes" bson:"delay_minutes" example:"30"`
+	// Targets son los usuarios, equipos o canales a los que se escala el evento
+	Targets []string `json:"targets" bson:"targets" example:"ana,guardia-operaciones"`
+}
+
+// Delay devuelve el retraso del nivel
+func (l EscalationLevel) Delay() time.Duration {
+	return time.Duration(l.DelayMinutes) * time.Minute
+}
+
+// CreateEscalationPolicyRequest representa la solicitud para crear una política de escalado
+type CreateEscalationPolicyRequest struct {
+	Name      string            `json:"name" example:"Escalado de emergencias" binding:"required"`
+	EventType EventType         `json:"eventType" example:"EMERGENCY" binding:"required"`
+	Enabled   *bool             `json:"enabled" example:"true"`
+	Levels    []EscalationLevel `json:"levels" binding:"required"`
+}
+
+// UpdateEscalationPolicyRequest representa la solicitud para actualizar una política de escalado.
+// Solo se modifican los campos proporcionados.
+type UpdateEscalationPolicyRequest struct {
+	Name      *string            `json:"name" example:"Escalado de emergencias"`
+	EventType *EventType         `json:"eventType" example:"EMERGENCY"`
+	Enabled   *bool              `json:"enabled" example:"false"`
+	Levels    *[]EscalationLevel `json:"levels"`
+}
+
+// EventEscalation representa el estado del escalado de un evento
+type EventEscalation struct {
+	PolicyID string   `json:"policyId" bson:"policy_id"`
+	Level    int      `json:"level" bson:"level" example:"1"`
+	Targets  []string `json:"targets" bson:"targets" example:"ana,guardia-operaciones"`
+	// StartedAt es el inicio del periodo sin atender a partir del que se cuentan los retrasos
+	StartedAt      time.Time  `json:"startedAt" bson:"started_at"`
+	EscalatedAt    time.Time  `json:"escalatedAt" bson:"escalated_at"`
+	AcknowledgedBy string     `json:"acknowledgedBy,omitempty" bson:"acknowledged_by,omitempty" example:"ana"`
+	AcknowledgedAt *time.Time `json:"acknowledgedAt,omitempty" bson:"acknowledged_at,omitempty"`
+}
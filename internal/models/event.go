@@ -15,16 +15,30 @@ type ManagementStatus string
 // EventType es un tipo para representar el tipo de evento
 type EventType string
 
+// ManagementSource es un tipo para representar el origen del estado de gestión
+type ManagementSource string
+
 const (
 	// Estados del evento
-	StatusPending  EventStatus = "PENDING"
-	StatusReviewed EventStatus = "REVIEWED"
+	StatusPending    EventStatus = "PENDING"
+	StatusReviewed   EventStatus = "REVIEWED"
+	StatusInProgress EventStatus = "IN_PROGRESS"
+	StatusResolved   EventStatus = "RESOLVED"
+	StatusClosed     EventStatus = "CLOSED"
+	StatusReopened   EventStatus = "REOPENED"
 
 	// Estados de gestión
 	ManagementRequired    ManagementStatus = "REQUIRES_MANAGEMENT"
 	ManagementNotRequired ManagementStatus = "NO_MANAGEMENT"
 
-	// Tipos de evento (ejemplo)
+	// Orígenes del estado de gestión
+	ManagementSourceAutomatic ManagementSource = "AUTOMATIC"
+	ManagementSourceManual    ManagementSource = "MANUAL"
+
+	// AnyVersion indica que una operación no exige una versión concreta del evento
+	AnyVersion int64 = -1
+
+	// Tipos de evento predefinidos; el registro de tipos admite otros (ver BuiltinEventTypes)
 	TypeEmergency    EventType = "EMERGENCY"
 	TypeMaintenance  EventType = "MAINTENANCE"
 	TypeNotification EventType = "NOTIFICATION"
@@ -34,23 +48,62 @@ const (
 
 // Event representa la estructura de un evento
 type Event struct {
-	ID               primitive.ObjectID `json:"id" bson:"_id,omitempty"`
-	Name             string             `json:"name" bson:"name" binding:"required"`
-	Type             EventType          `json:"type" bson:"type" binding:"required"`
-	Description      string             `json:"description" bson:"description" binding:"required"`
-	Date             time.Time          `json:"date" bson:"date"`
-	Status           EventStatus        `json:"status" bson:"status"`
-	ManagementStatus ManagementStatus   `json:"managementStatus,omitempty" bson:"management_status,omitempty"`
-	CreatedAt        time.Time          `json:"createdAt" bson:"created_at"`
-	UpdatedAt        time.Time          `json:"updatedAt" bson:"updated_at"`
+	ID                 primitive.ObjectID     `json:"id" bson:"_id,omitempty"`
+	Name               string                 `json:"name" bson:"name" binding:"required"`
+	Type               EventType              `json:"type" bson:"type" binding:"required"`
+	Description        string                 `json:"description" bson:"description" binding:"required"`
+	Date               time.Time              `json:"date" bson:"date"`
+	Status             EventStatus            `json:"status" bson:"status"`
+	Priority           Priority               `json:"priority" bson:"priority"`
+	Severity           int                    `json:"severity" bson:"severity"`
+	Tags               []string               `json:"tags,omitempty" bson:"tags,omitempty"`
+	Labels             map[string]string      `json:"labels,omitempty" bson:"labels,omitempty"`
+	Attributes         map[string]interface{} `json:"attributes,omitempty" bson:"attributes,omitempty"`
+	ManagementStatus   ManagementStatus       `json:"managementStatus,omitempty" bson:"management_status,omitempty"`
+	ManagementRuleID   string                 `json:"managementRuleId,omitempty" bson:"management_rule_id,omitempty"`
+	ManagementRuleName string                 `json:"managementRuleName,omitempty" bson:"management_rule_name,omitempty"`
+	ManagementSource   ManagementSource       `json:"managementSource,omitempty" bson:"management_source,omitempty"`
+	Justification      string                 `json:"justification,omitempty" bson:"justification,omitempty"`
+	AssigneeID         string                 `json:"assigneeId,omitempty" bson:"assignee_id,omitempty"`
+	TeamID             string                 `json:"teamId,omitempty" bson:"team_id,omitempty"`
+	StatusNote         string                 `json:"statusNote,omitempty" bson:"status_note,omitempty"`
+	ResolutionNote     string                 `json:"resolutionNote,omitempty" bson:"resolution_note,omitempty"`
+	StartedAt          *time.Time             `json:"startedAt,omitempty" bson:"started_at,omitempty"`
+	ResolvedAt         *time.Time             `json:"resolvedAt,omitempty" bson:"resolved_at,omitempty"`
+	ClosedAt           *time.Time             `json:"closedAt,omitempty" bson:"closed_at,omitempty"`
+	ReopenedAt         *time.Time             `json:"reopenedAt,omitempty" bson:"reopened_at,omitempty"`
+	ReviewDueAt        *time.Time             `json:"reviewDueAt,omitempty" bson:"review_due_at,omitempty"`
+	ResolveDueAt       *time.Time             `json:"resolveDueAt,omitempty" bson:"resolve_due_at,omitempty"`
+	ReviewBreachedAt   *time.Time             `json:"reviewBreachedAt,omitempty" bson:"review_breached_at,omitempty"`
+	ResolveBreachedAt  *time.Time             `json:"resolveBreachedAt,omitempty" bson:"resolve_breached_at,omitempty"`
+	Escalation         *EventEscalation       `json:"escalation,omitempty" bson:"escalation,omitempty"`
+	CommentCount       int64                  `json:"commentCount" bson:"comment_count,omitempty"`
+	Attachments        []Attachment           `json:"attachments,omitempty" bson:"attachments,omitempty"`
+	CreatedAt          time.Time              `json:"createdAt" bson:"created_at"`
+	UpdatedAt          time.Time              `json:"updatedAt" bson:"updated_at"`
+	DeletedAt          *time.Time             `json:"deletedAt,omitempty" bson:"deleted_at,omitempty"`
+	Version            int64                  `json:"version" bson:"version"`
+	// EscalationDueAt es el instante en que la tarea de escalado debe volver a evaluar el evento;
+	// se elimina con cada modificación del evento para que se evalúe de nuevo. No se expone.
+	EscalationDueAt *time.Time `json:"-" bson:"escalation_due_at,omitempty"`
+	// Score es la relevancia calculada por MongoDB en las búsquedas de texto; no se persiste
+	Score float64 `json:"-" bson:"score,omitempty"`
 }
 
-// CreateEventRequest representa la solicitud para crear un evento
+// CreateEventRequest representa la solicitud para crear un evento.
+// Si no se indican la prioridad o la severidad, se derivan del tipo de evento.
 type CreateEventRequest struct {
 	Name        string    `json:"name" example:"Mantenimiento programado" binding:"required"`
 	Type        EventType `json:"type" example:"MAINTENANCE" binding:"required"`
 	Description string    `json:"description" example:"Mantenimiento programado del sistema para actualización" binding:"required"`
 	Date        time.Time `json:"date" example:"2025-04-08T00:00:00Z" binding:"required"`
+	Priority    Priority  `json:"priority,omitempty" example:"P3"`
+	Severity    *int      `json:"severity,omitempty" example:"3" binding:"omitempty,min=1,max=5"`
+	// Tags y Labels clasifican el evento libremente, por ejemplo por sistema, región o cliente
+	Tags   []string          `json:"tags,omitempty" example:"facturacion,base-de-datos"`
+	Labels map[string]string `json:"labels,omitempty"`
+	// Attributes son los campos personalizados del evento, validados con el esquema de su tipo
+	Attributes map[string]interface{} `json:"attributes,omitempty"`
 }
 
 // UpdateEventRequest representa la solicitud para actualizar un evento
@@ -59,22 +112,64 @@ type UpdateEventRequest struct {
 	Type        EventType `json:"type" example:"MAINTENANCE"`
 	Description string    `json:"description" example:"Actualización de la descripción del mantenimiento programado"`
 	Date        time.Time `json:"date" example:"2025-04-15T00:00:00Z"`
+	Priority    Priority  `json:"priority" example:"P2"`
+	Severity    *int      `json:"severity" example:"4" binding:"omitempty,min=1,max=5"`
+	// Tags y Labels sustituyen a los del evento si se indican; una lista o un objeto vacíos los eliminan
+	Tags   []string          `json:"tags" example:"facturacion"`
+	Labels map[string]string `json:"labels"`
+	// Attributes sustituye a los campos personalizados del evento si se indica; un objeto vacío los elimina
+	Attributes map[string]interface{} `json:"attributes"`
 }
 
-// ReviewEventRequest representa la solicitud para revisar un evento
+// ReviewEventRequest representa la solicitud para revisar un evento.
+// Si se indica ManagementStatus, la revisión es manual y sustituye la clasificación
+// automática; en ese caso la justificación es obligatoria.
 type ReviewEventRequest struct {
-	ManagementStatus ManagementStatus `json:"managementStatus" binding:"required"`
+	ManagementStatus ManagementStatus `json:"managementStatus" example:"REQUIRES_MANAGEMENT"`
+	Justification    string           `json:"justification" example:"El mantenimiento afecta a sistemas críticos"`
+	// Comment se publica, si se indica, en el hilo de comentarios del evento
+	Comment string `json:"comment" example:"Revisado con @luis: afecta solo al CPD norte"`
 }
 
 // EventResponse representa la respuesta de un evento
 type EventResponse struct {
-	ID               string    `json:"id"`
-	Name             string    `json:"name"`
-	Type             string    `json:"type"`
-	Description      string    `json:"description"`
-	Date             time.Time `json:"date"`
-	Status           string    `json:"status"`
-	ManagementStatus string    `json:"managementStatus,omitempty"`
-	CreatedAt        time.Time `json:"createdAt"`
-	UpdatedAt        time.Time `json:"updatedAt"`
+	ID                 string                 `json:"id"`
+	Name               string                 `json:"name"`
+	Type               string                 `json:"type"`
+	Description        string                 `json:"description"`
+	Date               time.Time              `json:"date"`
+	Status             string                 `json:"status"`
+	Priority           string                 `json:"priority" example:"P3"`
+	Severity           int                    `json:"severity" example:"3"`
+	Tags               []string               `json:"tags" example:"facturacion,base-de-datos"`
+	Labels             map[string]string      `json:"labels"`
+	Attributes         map[string]interface{} `json:"attributes"`
+	ManagementStatus   string                 `json:"managementStatus,omitempty"`
+	ManagementRuleID   string                 `json:"managementRuleId,omitempty"`
+	ManagementRuleName string                 `json:"managementRuleName,omitempty" example:"Emergencias críticas"`
+	ManagementSource   string                 `json:"managementSource,omitempty" example:"AUTOMATIC"`
+	Justification      string                 `json:"justification,omitempty"`
+	AssigneeID         string                 `json:"assigneeId,omitempty" example:"ana"`
+	TeamID             string                 `json:"teamId,omitempty" example:"6512bd43d9caa6e02c990b0a"`
+	StatusNote         string                 `json:"statusNote,omitempty" example:"Se reinició el servicio afectado"`
+	ResolutionNote     string                 `json:"resolutionNote,omitempty" example:"Se reinició el servicio afectado"`
+	StartedAt          *time.Time             `json:"startedAt,omitempty"`
+	ResolvedAt         *time.Time             `json:"resolvedAt,omitempty"`
+	ClosedAt           *time.Time             `json:"closedAt,omitempty"`
+	ReopenedAt         *time.Time             `json:"reopenedAt,omitempty"`
+	SLA                *SLAStatus             `json:"sla,omitempty"`
+	Escalation         *EventEscalation       `json:"escalation,omitempty"`
+	CommentCount       int64                  `json:"commentCount" example:"2"`
+	Attachments        []Attachment           `json:"attachments"`
+	CreatedAt          time.Time              `json:"createdAt"`
+	UpdatedAt          time.Time              `json:"updatedAt"`
+	DeletedAt          *time.Time             `json:"deletedAt,omitempty"`
+	Version            int64                  `json:"version" example:"3"`
+	Score              float64                `json:"score,omitempty"`
+}
+
+// EventListResponse representa una página de eventos junto a sus metadatos de paginación
+type EventListResponse struct {
+	Data       []EventResponse `json:"data"`
+	Pagination Pagination      `json:"pagination"`
 }
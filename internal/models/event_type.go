@@ -0,0 +1,140 @@
+package models
+
+import (
+	"encoding/json"
+	"regexp"
+	"time"
+)
+
+var (
+	// EventTypeCodePattern es el formato del código de un tipo de evento, que se normaliza a mayúsculas
+	EventTypeCodePattern = regexp.MustCompile(`^[A-Z][A-Z0-9_]{0,31}$`)
+	// EventTypeColorPattern es el formato del color de un tipo de evento (#RRGGBB)
+	EventTypeColorPattern = regexp.MustCompile(`^#[0-9A-Fa-f]{6}$`)
+)
+
+// EventTypeDefinition representa un tipo de evento del registro de tipos. La prioridad, la
+// severidad y la clasificación de gestión del tipo se aplican a sus eventos cuando no se indican
+// o cuando ninguna regla de gestión coincide. Si el tipo declara un esquema JSON, los campos
+// personalizados de sus eventos deben cumplirlo.
+type EventTypeDefinition struct {
+	Code                    EventType        `json:"code" bson:"_id" example:"OUTAGE"`
+	Name                    string           `json:"name" bson:"name" example:"Caída de servicio"`
+	Description             string           `json:"description" bson:"description" example:"Interrupción total de un servicio en producción"`
+	Color                   string           `json:"color" bson:"color" example:"#D32F2F"`
+	DefaultManagementStatus ManagementStatus `json:"defaultManagementStatus" bson:"default_management_status" example:"REQUIRES_MANAGEMENT"`
+	DefaultPriority         Priority         `json:"defaultPriority" bson:"default_priority" example:"P1"`
+	DefaultSeverity         int              `json:"defaultSeverity" bson:"default_severity" example:"5"`
+	AttributesSchema        json.RawMessage  `json:"attributesSchema,omitempty" bson:"attributes_schema,omitempty" swaggertype:"object"`
+	// Active indica si se pueden crear eventos del tipo; los eventos existentes lo conservan
+	Active    bool      `json:"active" bson:"active"`
+	CreatedAt time.Time `json:"createdAt" bson:"created_at"`
+	UpdatedAt time.Time `json:"updatedAt" bson:"updated_at"`
+}
+
+// CreateEventTypeRequest representa la solicitud para registrar un tipo de evento. Si no se
+// indican la prioridad o la severidad predeterminadas se usan P3 y 3.
+type CreateEventTypeRequest struct {
+	Code                    EventType        `json:"code" example:"OUTAGE" binding:"required"`
+	Name                    string           `json:"name" example:"Caída de servicio" binding:"required"`
+	Description             string           `json:"description" example:"Interrupción total de un servicio en producción"`
+	Color                   string           `json:"color" example:"#D32F2F"`
+	DefaultManagementStatus ManagementStatus `json:"defaultManagementStatus" example:"REQUIRES_MANAGEMENT" binding:"required"`
+	DefaultPriority         Priority         `json:"defaultPriority" example:"P1"`
+	DefaultSeverity         *int             `json:"defaultSeverity" example:"5" binding:"omitempty,min=1,max=5"`
+	AttributesSchema        json.RawMessage  `json:"attributesSchema" swaggertype:"object"`
+	Active                  *bool            `json:"active" example:"true"`
+}
+
+// UpdateEventTypeRequest representa la solicitud para actualizar un tipo de evento. El código
+// no se puede cambiar. Un esquema null elimina el esquema del tipo.
+type UpdateEventTypeRequest struct {
+	Name                    *string           `json:"name" example:"Caída de servicio"`
+	Description             *string           `json:"description" example:"Interrupción total o parcial de un servicio en producción"`
+	Color                   *string           `json:"color" example:"#C62828"`
+	DefaultManagementStatus *ManagementStatus `json:"defaultManagementStatus" example:"REQUIRES_MANAGEMENT"`
+	DefaultPriority         *Priority         `json:"defaultPriority" example:"P2"`
+	DefaultSeverity         *int              `json:"defaultSeverity" example:"4" binding:"omitempty,min=1,max=5"`
+	AttributesSchema        json.RawMessage   `json:"attributesSchema" swaggertype:"object"`
+	Active                  *bool             `json:"active" example:"false"`
+}
+
+// BuiltinEventTypes son los tipos de evento que se registran al iniciar la aplicación si no
+// existen. Una vez registrados se gestionan como cualquier otro tipo. Los esquemas de ALERT y
+// MAINTENANCE exigen sus campos personalizados habituales: el origen y el umbral de la alerta, y
+// la ventana y los sistemas afectados del mantenimiento.
+var BuiltinEventTypes = []EventTypeDefinition{
+	{
+		Code:                    TypeEmergency,
+		Name:                    "Emergencia",
+		Description:             "Situación crítica que requiere atención inmediata",
+		Color:                   "#D32F2F",
+		DefaultManagementStatus: ManagementRequired,
+		DefaultPriority:         PriorityP1,
+		DefaultSeverity:         5,
+		Active:                  true,
+	},
+	{
+		Code:                    TypeAlert,
+		Name:                    "Alerta",
+		Description:             "Anomalía que puede derivar en una incidencia",
+		Color:                   "#F57C00",
+		DefaultManagementStatus: ManagementRequired,
+		DefaultPriority:         PriorityP2,
+		DefaultSeverity:         4,
+		AttributesSchema: json.RawMessage(`{
+			"type": "object",
+			"properties": {
+				"source": {"type": "string", "minLength": 1},
+				"threshold": {"type": "number"}
+			},
+			"required": ["source", "threshold"]
+		}`),
+		Active: true,
+	},
+	{
+		Code:                    TypeMaintenance,
+		Name:                    "Mantenimiento",
+		Description:             "Intervención planificada sobre los sistemas",
+		Color:                   "#1976D2",
+		DefaultManagementStatus: ManagementNotRequired,
+		DefaultPriority:         PriorityP3,
+		DefaultSeverity:         3,
+		AttributesSchema: json.RawMessage(`{
+			"type": "object",
+			"properties": {
+				"window": {
+					"type": "object",
+					"properties": {
+						"start": {"type": "string", "format": "date-time"},
+						"end": {"type": "string", "format": "date-time"}
+					},
+					"required": ["start", "end"]
+				},
+				"affectedSystems": {"type": "array", "items": {"type": "string", "minLength": 1}, "minItems": 1, "uniqueItems": true}
+			},
+			"required": ["window", "affectedSystems"]
+		}`),
+		Active: true,
+	},
+	{
+		Code:                    TypeNotification,
+		Name:                    "Notificación",
+		Description:             "Aviso que no requiere intervención",
+		Color:                   "#388E3C",
+		DefaultManagementStatus: ManagementNotRequired,
+		DefaultPriority:         PriorityP4,
+		DefaultSeverity:         2,
+		Active:                  true,
+	},
+	{
+		Code:                    TypeInfo,
+		Name:                    "Información",
+		Description:             "Evento informativo",
+		Color:                   "#757575",
+		DefaultManagementStatus: ManagementNotRequired,
+		DefaultPriority:         PriorityP4,
+		DefaultSeverity:         1,
+		Active:                  true,
+	},
+}
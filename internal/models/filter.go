@@ -0,0 +1,24 @@
+package models
+
+import "time"
+
+// EventFilter representa los criterios de filtrado de un listado de eventos
+type EventFilter struct {
+	Types              []EventType        `json:"types,omitempty" example:"ALERT"`
+	Statuses           []EventStatus      `json:"statuses,omitempty" example:"PENDING"`
+	ManagementStatuses []ManagementStatus `json:"managementStatuses,omitempty"`
+	DateFrom           *time.Time         `json:"dateFrom,omitempty"`
+	DateTo             *time.Time         `json:"dateTo,omitempty"`
+	CreatedFrom        *time.Time         `json:"createdFrom,omitempty"`
+	CreatedTo          *time.Time         `json:"createdTo,omitempty"`
+	AssigneeIDs        []string           `json:"assigneeIds,omitempty" example:"ana"`
+	TeamIDs            []string           `json:"teamIds,omitempty"`
+	Unassigned         bool               `json:"unassigned,omitempty"`
+	// Tags exige que el evento tenga todas las etiquetas indicadas
+	Tags []string `json:"tags,omitempty" example:"facturacion"`
+	// Labels exige que el evento tenga, para cada clave, alguno de los valores indicados
+	Labels map[string][]string `json:"labels,omitempty"`
+	// Attributes exige que el evento tenga, para cada ruta de sus campos personalizados
+	// (window.start), alguno de los valores indicados
+	Attributes map[string][]string `json:"attributes,omitempty"`
+}
@@ -0,0 +1,53 @@
+package models
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// HistoryOperation es un tipo para representar la operación registrada en el historial
+type HistoryOperation string
+
+const (
+	// Operaciones del historial de eventos
+	OperationCreate      HistoryOperation = "CREATE"
+	OperationUpdate      HistoryOperation = "UPDATE"
+	OperationReview      HistoryOperation = "REVIEW"
+	OperationUnreview    HistoryOperation = "UNREVIEW"
+	OperationDelete      HistoryOperation = "DELETE"
+	OperationRestore     HistoryOperation = "RESTORE"
+	OperationAssign      HistoryOperation = "ASSIGN"
+	OperationUnassign    HistoryOperation = "UNASSIGN"
+	OperationTransition  HistoryOperation = "TRANSITION"
+	OperationEscalate    HistoryOperation = "ESCALATE"
+	OperationAcknowledge HistoryOperation = "ACKNOWLEDGE"
+	OperationAttach      HistoryOperation = "ATTACH"
+	OperationDetach      HistoryOperation = "DETACH"
+	OperationTag         HistoryOperation = "TAG"
+	OperationUntag       HistoryOperation = "UNTAG"
+	OperationPurge       HistoryOperation = "PURGE"
+)
+
+// FieldChange representa el cambio de un campo del evento
+type FieldChange struct {
+	Field  string      `json:"field" bson:"field" example:"status"`
+	Before interface{} `json:"before" bson:"before"`
+	After  interface{} `json:"after" bson:"after"`
+}
+
+// EventHistoryEntry representa una entrada del historial de cambios de un evento
+type EventHistoryEntry struct {
+	ID        primitive.ObjectID `json:"id" bson:"_id,omitempty"`
+	EventID   primitive.ObjectID `json:"eventId" bson:"event_id"`
+	Actor     string             `json:"actor" bson:"actor" example:"jdoe"`
+	Operation HistoryOperation   `json:"operation" bson:"operation" example:"REVIEW"`
+	Timestamp time.Time          `json:"timestamp" bson:"timestamp"`
+	Changes   []FieldChange      `json:"changes" bson:"changes"`
+}
+
+// EventHistoryListResponse representa una página del historial de un evento
+type EventHistoryListResponse struct {
+	Data       []EventHistoryEntry `json:"data"`
+	Pagination Pagination          `json:"pagination"`
+}
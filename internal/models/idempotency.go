@@ -0,0 +1,19 @@
+package models
+
+import "time"
+
+// IdempotencyRecord representa la respuesta almacenada para una clave de idempotencia.
+// Mientras la solicitud original se procesa, Completed es false y no hay respuesta.
+type IdempotencyRecord struct {
+	ID          string            `bson:"_id"`
+	Key         string            `bson:"key"`
+	Actor       string            `bson:"actor"`
+	Method      string            `bson:"method"`
+	Path        string            `bson:"path"`
+	RequestHash string            `bson:"request_hash"`
+	Completed   bool              `bson:"completed"`
+	StatusCode  int               `bson:"status_code,omitempty"`
+	Headers     map[string]string `bson:"headers,omitempty"`
+	Body        []byte            `bson:"body,omitempty"`
+	CreatedAt   time.Time         `bson:"created_at"`
+}
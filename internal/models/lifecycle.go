@@ -0,0 +1,45 @@
+package models
+
+// EventTransitions es la tabla de transiciones del ciclo de vida de un evento: para cada estado,
+// los estados a los que puede pasar. Volver a revisar un evento revisado o reabierto permite
+// reclasificarlo.
+var EventTransitions = map[EventStatus][]EventStatus{
+	StatusPending:    {StatusReviewed},
+	StatusReviewed:   {StatusReviewed, StatusPending, StatusInProgress, StatusResolved, StatusClosed},
+	StatusInProgress: {StatusResolved},
+	StatusResolved:   {StatusClosed, StatusReopened},
+	StatusClosed:     {StatusReopened},
+	StatusReopened:   {StatusReviewed, StatusInProgress, StatusResolved},
+}
+
+// EventStatuses son los estados del ciclo de vida de un evento en su orden habitual
+var EventStatuses = []EventStatus{
+	StatusPending,
+	StatusReviewed,
+	StatusInProgress,
+	StatusResolved,
+	StatusClosed,
+	StatusReopened,
+}
+
+// CanTransition indica si un evento puede pasar del estado from al estado to
+func CanTransition(from, to EventStatus) bool {
+	for _, next := range EventTransitions[from] {
+		if next == to {
+			return true
+		}
+	}
+	return false
+}
+
+// EventStatusInfo describe un estado del ciclo de vida y los estados a los que puede pasar
+type EventStatusInfo struct {
+	Status EventStatus   `json:"status" example:"RESOLVED"`
+	Next   []EventStatus `json:"next" example:"CLOSED,REOPENED"`
+}
+
+// TransitionEventRequest representa la solicitud para cambiar el estado de un evento.
+// La nota es obligatoria al resolver el evento.
+type TransitionEventRequest struct {
+	Note string `json:"note" example:"Se reinició el servicio afectado"`
+}
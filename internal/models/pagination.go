@@ -0,0 +1,92 @@
+package models
+
+const (
+	// DefaultPageSize es el tamaño de página usado cuando no se especifica
+	DefaultPageSize int64 = 20
+	// MaxPageSize es el tamaño de página máximo permitido
+	MaxPageSize int64 = 100
+)
+
+// SortableEventFields relaciona los campos de ordenación admitidos con su campo en MongoDB
+var SortableEventFields = map[string]string{
+	"date":       "date",
+	"name":       "name",
+	"type":       "type",
+	"status":     "status",
+	"priority":   "priority",
+	"severity":   "severity",
+	"createdAt":  "created_at",
+	"created_at": "created_at",
+	"updatedAt":  "updated_at",
+	"updated_at": "updated_at",
+}
+
+// SortField representa un criterio de ordenación sobre un campo de MongoDB
+type SortField struct {
+	Field      string
+	Descending bool
+}
+
+// ListOptions representa las opciones de paginación y ordenación de un listado.
+// Si Cursor está definido se usa paginación por clave (keyset) en lugar de por desplazamiento.
+type ListOptions struct {
+	Page     int64
+	PageSize int64
+	Sort     []SortField
+	Cursor   *EventCursor
+}
+
+// Skip devuelve el número de documentos a omitir según la página solicitada
+func (o ListOptions) Skip() int64 {
+	if o.Cursor != nil || o.Page <= 1 {
+		return 0
+	}
+	return (o.Page - 1) * o.PageSize
+}
+
+// Pagination representa los metadatos de paginación de una respuesta.
+// En la paginación por cursor no se informan la página ni el total de páginas.
+type Pagination struct {
+	Page       int64  `json:"page,omitempty" example:"1"`
+	PageSize   int64  `json:"pageSize" example:"20"`
+	Total      int64  `json:"total" example:"135"`
+	TotalPages int64  `json:"totalPages,omitempty" example:"7"`
+	Next       string `json:"next,omitempty" example:"/api/v1/events?page=2&pageSize=20"`
+	Prev       string `json:"prev,omitempty"`
+	NextCursor string `json:"nextCursor,omitempty" example:"eyJ0IjoxNzEyNTM0NDAwMDAwLCJpZCI6IjY2MTNmMmE..."`
+}
+
+// NewPagination construye los metadatos de paginación a partir de las opciones y el total
+func NewPagination(opts ListOptions, total int64) Pagination {
+	if opts.Cursor != nil {
+		return Pagination{
+			PageSize: opts.PageSize,
+			Total:    total,
+		}
+	}
+
+	totalPages := int64(0)
+	if opts.PageSize > 0 {
+		totalPages = (total + opts.PageSize - 1) / opts.PageSize
+	}
+
+	return Pagination{
+		Page:       opts.Page,
+		PageSize:   opts.PageSize,
+		Total:      total,
+		TotalPages: totalPages,
+	}
+}
+
+// HasNext indica si existe una página posterior a la actual
+func (p Pagination) HasNext() bool {
+	if p.Page == 0 {
+		return p.NextCursor != ""
+	}
+	return p.Page < p.TotalPages
+}
+
+// HasPrev indica si existe una página anterior a la actual
+func (p Pagination) HasPrev() bool {
+	return p.Page > 1
+}
@@ -0,0 +1,9 @@
+package models
+
+// Tipos de contenido aceptados para las actualizaciones parciales de eventos
+const (
+	// MergePatchContentType identifica un documento JSON Merge Patch (RFC 7396)
+	MergePatchContentType = "application/merge-patch+json"
+	// JSONPatchContentType identifica una lista de operaciones JSON Patch (RFC 6902)
+	JSONPatchContentType = "application/json-patch+json"
+)
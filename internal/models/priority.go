@@ -0,0 +1,16 @@
+package models
+
+// Priority es un tipo para representar la prioridad de atención de un evento
+type Priority string
+
+const (
+	// Prioridades del evento, de la más urgente a la menos urgente
+	PriorityP1 Priority = "P1"
+	PriorityP2 Priority = "P2"
+	PriorityP3 Priority = "P3"
+	PriorityP4 Priority = "P4"
+
+	// Límites de la severidad del evento; un valor mayor indica un evento más grave
+	MinSeverity = 1
+	MaxSeverity = 5
+)
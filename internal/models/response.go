@@ -1,8 +1,12 @@
 package models
 
+import "events-api/internal/apierror"
+
 // ErrorResponse representa la estructura de respuesta para errores de la API en la documentaicón
 type ErrorResponse struct {
 	Error string `json:"error" example:"mensaje descriptivo del error"`
+	// Details enumera los campos no válidos en los errores de validación que los identifican
+	Details []apierror.FieldError `json:"details,omitempty"`
 }
 
 // SuccessResponse representa una respuesta exitosa con mensaje  en la documentaicón
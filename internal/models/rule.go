@@ -0,0 +1,67 @@
+package models
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// BuiltinRuleName identifica la clasificación por tipo usada cuando ninguna regla coincide
+const BuiltinRuleName = "BUILTIN_BY_TYPE"
+
+// RuleConditions representa las condiciones que debe cumplir un evento para que aplique una regla.
+// Todas las condiciones definidas deben cumplirse; una regla sin condiciones coincide con cualquier evento.
+type RuleConditions struct {
+	// Types coincide si el tipo del evento es alguno de los indicados
+	Types []EventType `json:"types,omitempty" bson:"types,omitempty" example:"EMERGENCY,ALERT"`
+	// Keywords coincide si el nombre o la descripción contienen alguna de las palabras (sin distinguir mayúsculas)
+	Keywords []string `json:"keywords,omitempty" bson:"keywords,omitempty" example:"caída,intrusión"`
+	// ExcludeKeywords descarta el evento si el nombre o la descripción contienen alguna de las palabras
+	ExcludeKeywords []string `json:"excludeKeywords,omitempty" bson:"exclude_keywords,omitempty" example:"prueba"`
+	// DateWithinHours coincide si la fecha del evento está a lo sumo a ese número de horas del momento de la revisión
+	DateWithinHours *int `json:"dateWithinHours,omitempty" bson:"date_within_hours,omitempty" example:"24"`
+}
+
+// ManagementRule representa una regla de asignación automática del estado de gestión
+type ManagementRule struct {
+	ID          primitive.ObjectID `json:"id" bson:"_id,omitempty"`
+	Name        string             `json:"name" bson:"name"`
+	Description string             `json:"description" bson:"description"`
+	Priority    int                `json:"priority" bson:"priority"`
+	Enabled     bool               `json:"enabled" bson:"enabled"`
+	IsDefault   bool               `json:"isDefault" bson:"is_default"`
+	Conditions  RuleConditions     `json:"conditions" bson:"conditions"`
+	Result      ManagementStatus   `json:"result" bson:"result"`
+	CreatedAt   time.Time          `json:"createdAt" bson:"created_at"`
+	UpdatedAt   time.Time          `json:"updatedAt" bson:"updated_at"`
+}
+
+// CreateRuleRequest representa la solicitud para crear una regla de gestión
+type CreateRuleRequest struct {
+	Name        string           `json:"name" example:"Emergencias críticas" binding:"required"`
+	Description string           `json:"description" example:"Las emergencias siempre requieren gestión"`
+	Priority    int              `json:"priority" example:"10"`
+	Enabled     *bool            `json:"enabled" example:"true"`
+	IsDefault   bool             `json:"isDefault" example:"false"`
+	Conditions  RuleConditions   `json:"conditions"`
+	Result      ManagementStatus `json:"result" example:"REQUIRES_MANAGEMENT" binding:"required"`
+}
+
+// UpdateRuleRequest representa la solicitud para actualizar una regla de gestión.
+// Solo se modifican los campos proporcionados.
+type UpdateRuleRequest struct {
+	Name        *string           `json:"name" example:"Emergencias críticas"`
+	Description *string           `json:"description" example:"Las emergencias siempre requieren gestión"`
+	Priority    *int              `json:"priority" example:"5"`
+	Enabled     *bool             `json:"enabled" example:"false"`
+	IsDefault   *bool             `json:"isDefault" example:"false"`
+	Conditions  *RuleConditions   `json:"conditions"`
+	Result      *ManagementStatus `json:"result" example:"NO_MANAGEMENT"`
+}
+
+// RuleEvaluation representa el resultado de evaluar las reglas de gestión sobre un evento
+type RuleEvaluation struct {
+	ManagementStatus ManagementStatus
+	RuleID           string
+	RuleName         string
+}
@@ -0,0 +1,64 @@
+package models
+
+import "time"
+
+// SLAPhase es un tipo para representar la fase del ciclo de vida a la que se aplica un plazo
+type SLAPhase string
+
+const (
+	// Fases del acuerdo de nivel de servicio
+	SLAPhaseReview  SLAPhase = "REVIEW"
+	SLAPhaseResolve SLAPhase = "RESOLVE"
+)
+
+// SLAPolicy representa los plazos de atención de un tipo de evento. Un plazo de 0 minutos
+// indica que la fase no tiene plazo.
+type SLAPolicy struct {
+	Type EventType `json:"type" bson:"_id" example:"EMERGENCY"`
+	// TimeToReviewMinutes es el plazo para revisar el evento desde su creación
+	TimeToReviewMinutes int `json:"timeToReviewMinutes" bson:"time_to_review_minutes" example:"15"`
+	// TimeToResolveMinutes es el plazo para resolver un evento que requiere gestión desde su revisión
+	TimeToResolveMinutes int `json:"timeToResolveMinutes" bson:"time_to_resolve_minutes" example:"240"`
+	// IsDefault indica que el tipo no tiene una política configurada y se aplican los plazos predeterminados
+	IsDefault bool       `json:"isDefault" bson:"-"`
+	UpdatedAt *time.Time `json:"updatedAt,omitempty" bson:"updated_at,omitempty"`
+}
+
+// TimeToReview devuelve el plazo de revisión de la política
+func (p SLAPolicy) TimeToReview() time.Duration {
+	return time.Duration(p.TimeToReviewMinutes) * time.Minute
+}
+
+// TimeToResolve devuelve el plazo de resolución de la política
+func (p SLAPolicy) TimeToResolve() time.Duration {
+	return time.Duration(p.TimeToResolveMinutes) * time.Minute
+}
+
+// UpdateSLAPolicyRequest representa la solicitud para configurar los plazos de un tipo de evento
+type UpdateSLAPolicyRequest struct {
+	TimeToReviewMinutes  *int `json:"timeToReviewMinutes" example:"15" binding:"required,min=0"`
+	TimeToResolveMinutes *int `json:"timeToResolveMinutes" example:"240" binding:"required,min=0"`
+}
+
+// DefaultSLAPolicies relaciona cada tipo de evento con los plazos que se aplican cuando no
+// tiene una política configurada. Los tipos que no figuran aquí no tienen plazos predeterminados.
+var DefaultSLAPolicies = map[EventType]SLAPolicy{
+	TypeEmergency:    {Type: TypeEmergency, TimeToReviewMinutes: 15, TimeToResolveMinutes: 4 * 60},
+	TypeAlert:        {Type: TypeAlert, TimeToReviewMinutes: 60, TimeToResolveMinutes: 24 * 60},
+	TypeMaintenance:  {Type: TypeMaintenance},
+	TypeNotification: {Type: TypeNotification},
+	TypeInfo:         {Type: TypeInfo},
+}
+
+// SLAStatus describe el plazo vigente de un evento. Remaining son los segundos que quedan hasta
+// el vencimiento, negativos si ya ha vencido; en los eventos resueltos o cerrados se calcula en
+// el momento de la resolución o el cierre.
+type SLAStatus struct {
+	Phase     SLAPhase  `json:"phase" example:"RESOLVE"`
+	DueAt     time.Time `json:"dueAt"`
+	Breached  bool      `json:"breached" example:"false"`
+	Remaining int64     `json:"remaining" example:"3600"`
+}
+
+// ResolvingStatuses son los estados en los que corre el plazo de resolución de un evento
+var ResolvingStatuses = []EventStatus{StatusReviewed, StatusInProgress, StatusReopened}
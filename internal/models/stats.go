@@ -0,0 +1,59 @@
+package models
+
+// StatsGroup es un tipo para representar la dimensión de agrupación de las estadísticas
+type StatsGroup string
+
+// StatsInterval es un tipo para representar el intervalo de los histogramas
+type StatsInterval string
+
+// StatsDateField es un tipo para representar el campo de fecha usado en los histogramas
+type StatsDateField string
+
+const (
+	// Dimensiones de agrupación
+	GroupByType             StatsGroup = "type"
+	GroupByStatus           StatsGroup = "status"
+	GroupByManagementStatus StatsGroup = "managementStatus"
+
+	// Intervalos de histograma
+	IntervalDay   StatsInterval = "day"
+	IntervalWeek  StatsInterval = "week"
+	IntervalMonth StatsInterval = "month"
+
+	// Campos de fecha para histogramas
+	StatsDateFieldDate      StatsDateField = "date"
+	StatsDateFieldCreatedAt StatsDateField = "createdAt"
+
+	// StatsNoManagementStatus agrupa los eventos que aún no tienen estado de gestión
+	StatsNoManagementStatus = "NONE"
+)
+
+// EventStatsOptions representa las opciones de cálculo de las estadísticas de eventos
+type EventStatsOptions struct {
+	GroupBy   []StatsGroup
+	Interval  StatsInterval
+	DateField StatsDateField
+}
+
+// CountBucket representa el número de eventos para un valor de una dimensión
+type CountBucket struct {
+	Key   string `json:"key" example:"EMERGENCY"`
+	Count int64  `json:"count" example:"42"`
+}
+
+// HistogramBucket representa el número de eventos en un periodo del histograma
+type HistogramBucket struct {
+	Period string `json:"period" example:"2025-04-08"`
+	Count  int64  `json:"count" example:"12"`
+}
+
+// EventStats representa las estadísticas agregadas de eventos
+type EventStats struct {
+	Total              int64             `json:"total" example:"135"`
+	ByType             []CountBucket     `json:"byType,omitempty"`
+	ByStatus           []CountBucket     `json:"byStatus,omitempty"`
+	ByManagementStatus []CountBucket     `json:"byManagementStatus,omitempty"`
+	Interval           StatsInterval     `json:"interval,omitempty" example:"day"`
+	DateField          StatsDateField    `json:"dateField,omitempty" example:"createdAt"`
+	Histogram          []HistogramBucket `json:"histogram,omitempty"`
+}
@@ -0,0 +1,35 @@
+package models
+
+import "regexp"
+
+// Límites de las etiquetas y los atributos clave-valor de un evento
+const (
+	MaxEventTags        = 20
+	MaxTagLength        = 50
+	MaxEventLabels      = 20
+	MaxLabelKeyLength   = 63
+	MaxLabelValueLength = 256
+	// DefaultTagSuggestions y MaxTagSuggestions limitan la consulta de etiquetas para autocompletar
+	DefaultTagSuggestions = 20
+	MaxTagSuggestions     = 100
+)
+
+var (
+	// TagPattern es el formato de una etiqueta, que se normaliza a minúsculas. No admite barras
+	// para poder usarla como segmento de la ruta al quitarla de un evento.
+	TagPattern = regexp.MustCompile(`^[a-z0-9][a-z0-9._:-]*$`)
+	// LabelKeyPattern es el formato de la clave de un atributo, que se normaliza a minúsculas. No
+	// admite puntos para poder usarla como ruta en las consultas.
+	LabelKeyPattern = regexp.MustCompile(`^[a-z0-9]([a-z0-9_-]*[a-z0-9])?$`)
+)
+
+// TagEventRequest representa la solicitud para añadir etiquetas a un evento
+type TagEventRequest struct {
+	Tags []string `json:"tags" example:"facturacion,eu-west" binding:"required,min=1"`
+}
+
+// TagCount representa una etiqueta junto al número de eventos que la usan
+type TagCount struct {
+	Tag   string `json:"tag" bson:"_id" example:"facturacion"`
+	Count int64  `json:"count" bson:"count" example:"12"`
+}
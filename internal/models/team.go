@@ -0,0 +1,61 @@
+package models
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// Team representa un equipo que atiende los eventos que requieren gestión. Los eventos se
+// reparten entre sus miembros por turnos.
+type Team struct {
+	ID   primitive.ObjectID `json:"id" bson:"_id,omitempty"`
+	Name string             `json:"name" bson:"name"`
+	// Members son los IDs de usuario de los miembros, en el orden en que reciben eventos
+	Members []string `json:"members" bson:"members" example:"ana,luis"`
+	// EventTypes son los tipos de evento que atiende el equipo; si está vacío atiende cualquier tipo
+	EventTypes []EventType `json:"eventTypes,omitempty" bson:"event_types,omitempty" example:"EMERGENCY,ALERT"`
+	// AutoAssign indica si el equipo recibe automáticamente los eventos que pasan a requerir gestión
+	AutoAssign bool `json:"autoAssign" bson:"auto_assign"`
+	// AssignmentCount es el número de asignaciones realizadas, usado para el reparto por turnos
+	AssignmentCount int64     `json:"assignmentCount" bson:"assignment_count"`
+	CreatedAt       time.Time `json:"createdAt" bson:"created_at"`
+	UpdatedAt       time.Time `json:"updatedAt" bson:"updated_at"`
+}
+
+// CreateTeamRequest representa la solicitud para crear un equipo
+type CreateTeamRequest struct {
+	Name       string      `json:"name" example:"Guardia de operaciones" binding:"required"`
+	Members    []string    `json:"members" example:"ana,luis"`
+	EventTypes []EventType `json:"eventTypes" example:"EMERGENCY,ALERT"`
+	AutoAssign *bool       `json:"autoAssign" example:"true"`
+}
+
+// UpdateTeamRequest representa la solicitud para actualizar un equipo.
+// Solo se modifican los campos proporcionados.
+type UpdateTeamRequest struct {
+	Name       *string      `json:"name" example:"Guardia de operaciones"`
+	Members    *[]string    `json:"members" example:"ana,luis,marta"`
+	EventTypes *[]EventType `json:"eventTypes" example:"EMERGENCY"`
+	AutoAssign *bool        `json:"autoAssign" example:"false"`
+}
+
+// AssignEventRequest representa la solicitud para asignar un evento. Si solo se indica el
+// equipo, el evento se asigna por turnos a uno de sus miembros. Si no se indica el equipo, el
+// evento conserva el suyo; un equipo vacío lo quita.
+type AssignEventRequest struct {
+	AssigneeID string  `json:"assigneeId" example:"ana"`
+	TeamID     *string `json:"teamId" example:"6512bd43d9caa6e02c990b0a"`
+}
+
+// AssignmentRotation acumula los turnos de asignación automática usados por una operación
+// masiva, que solo se consumen en los equipos cuando la operación se aplica
+type AssignmentRotation struct {
+	Used map[string]int64
+}
+
+// Assignment representa el responsable de un evento
+type Assignment struct {
+	TeamID     string
+	AssigneeID string
+}
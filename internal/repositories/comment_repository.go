@@ -0,0 +1,191 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"events-api/internal/apierror"
+	"events-api/internal/config"
+	"events-api/internal/models"
+	"events-api/pkg/database"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// CommentRepository define las operaciones del repositorio de comentarios de eventos
+type CommentRepository interface {
+	FindByEventID(ctx context.Context, eventID primitive.ObjectID, opts models.ListOptions) ([]models.Comment, int64, error)
+	FindByID(ctx context.Context, eventID primitive.ObjectID, id string) (models.Comment, error)
+	Create(ctx context.Context, comment models.Comment) (models.Comment, error)
+	Update(ctx context.Context, comment models.Comment, edit models.CommentEdit) (models.Comment, error)
+	Delete(ctx context.Context, comment models.Comment) error
+	ArchiveByEventIDs(ctx context.Context, eventIDs []primitive.ObjectID, archivedAt time.Time) error
+	RestoreByEventID(ctx context.Context, eventID primitive.ObjectID) error
+	DeleteByEventIDs(ctx context.Context, eventIDs []primitive.ObjectID) (int64, error)
+}
+
+// commentRepository implementa CommentRepository
+type commentRepository struct {
+	collection *mongo.Collection
+}
+
+// NewCommentRepository crea una nueva instancia de CommentRepository y asegura sus índices
+func NewCommentRepository(client *mongo.Client, cfg *config.Config) (CommentRepository, error) {
+	collection := database.GetCollection(client, cfg, cfg.CommentsCollection)
+	repository := &commentRepository{
+		collection: collection,
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	_, err := collection.Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{
+			// Índice compuesto para el hilo de cada evento en orden cronológico
+			Keys:    bson.D{{Key: "event_id", Value: 1}, {Key: "created_at", Value: 1}, {Key: "_id", Value: 1}},
+			Options: options.Index().SetName("comments_event_created"),
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return repository, nil
+}
+
+// FindByEventID recupera una página de los comentarios de un evento, del más antiguo al más reciente
+func (r *commentRepository) FindByEventID(ctx context.Context, eventID primitive.ObjectID, opts models.ListOptions) ([]models.Comment, int64, error) {
+	filter := bson.M{"event_id": eventID, "archived_at": nil}
+
+	total, err := r.collection.CountDocuments(ctx, filter)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	findOpts := options.Find().
+		SetSort(bson.D{{Key: "created_at", Value: 1}, {Key: "_id", Value: 1}}).
+		SetSkip(opts.Skip()).
+		SetLimit(opts.PageSize)
+
+	cursor, err := r.collection.Find(ctx, filter, findOpts)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer cursor.Close(ctx)
+
+	var comments []models.Comment
+	if err := cursor.All(ctx, &comments); err != nil {
+		return nil, 0, err
+	}
+
+	return comments, total, nil
+}
+
+// FindByID recupera un comentario de un evento por su ID
+func (r *commentRepository) FindByID(ctx context.Context, eventID primitive.ObjectID, id string) (models.Comment, error) {
+	objectID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return models.Comment{}, apierror.NewError(apierror.BadRequest, "ID de comentario inválido")
+	}
+
+	var comment models.Comment
+	err = r.collection.FindOne(ctx, bson.M{"_id": objectID, "event_id": eventID, "archived_at": nil}).Decode(&comment)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return models.Comment{}, apierror.NewError(apierror.NotFound, "comentario no encontrado")
+		}
+		return models.Comment{}, apierror.NewError(apierror.Internal, "error al buscar el comentario: "+err.Error())
+	}
+
+	return comment, nil
+}
+
+// Create crea un nuevo comentario
+func (r *commentRepository) Create(ctx context.Context, comment models.Comment) (models.Comment, error) {
+	now := time.Now()
+
+	comment.CreatedAt = now
+	comment.UpdatedAt = now
+	comment.Edits = []models.CommentEdit{}
+
+	if comment.ID.IsZero() {
+		comment.ID = primitive.NewObjectID()
+	}
+
+	_, err := r.collection.InsertOne(ctx, comment)
+	if err != nil {
+		return models.Comment{}, apierror.NewError(apierror.Internal, "error al crear el comentario: "+err.Error())
+	}
+
+	return comment, nil
+}
+
+// Update guarda el nuevo cuerpo de un comentario y añade la versión anterior a su historial de ediciones
+func (r *commentRepository) Update(ctx context.Context, comment models.Comment, edit models.CommentEdit) (models.Comment, error) {
+	update := bson.M{
+		"$set": bson.M{
+			"body":       comment.Body,
+			"mentions":   comment.Mentions,
+			"updated_at": edit.EditedAt,
+		},
+		"$push": bson.M{"edits": edit},
+	}
+
+	filter := bson.M{"_id": comment.ID, "archived_at": nil}
+	opts := options.FindOneAndUpdate().SetReturnDocument(options.After)
+
+	var updated models.Comment
+	err := r.collection.FindOneAndUpdate(ctx, filter, update, opts).Decode(&updated)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return models.Comment{}, apierror.NewError(apierror.NotFound, "comentario no encontrado")
+		}
+		return models.Comment{}, apierror.NewError(apierror.Internal, "error al actualizar el comentario: "+err.Error())
+	}
+
+	return updated, nil
+}
+
+// Delete elimina un comentario
+func (r *commentRepository) Delete(ctx context.Context, comment models.Comment) error {
+	result, err := r.collection.DeleteOne(ctx, bson.M{"_id": comment.ID, "archived_at": nil})
+	if err != nil {
+		return apierror.NewError(apierror.Internal, "error al eliminar el comentario: "+err.Error())
+	}
+
+	if result.DeletedCount == 0 {
+		return apierror.NewError(apierror.NotFound, "comentario no encontrado")
+	}
+
+	return nil
+}
+
+// ArchiveByEventIDs archiva los comentarios de los eventos indicados
+func (r *commentRepository) ArchiveByEventIDs(ctx context.Context, eventIDs []primitive.ObjectID, archivedAt time.Time) error {
+	filter := bson.M{"event_id": bson.M{"$in": eventIDs}, "archived_at": nil}
+
+	_, err := r.collection.UpdateMany(ctx, filter, bson.M{"$set": bson.M{"archived_at": archivedAt}})
+	return err
+}
+
+// RestoreByEventID recupera los comentarios archivados de un evento
+func (r *commentRepository) RestoreByEventID(ctx context.Context, eventID primitive.ObjectID) error {
+	filter := bson.M{"event_id": eventID, "archived_at": bson.M{"$ne": nil}}
+
+	_, err := r.collection.UpdateMany(ctx, filter, bson.M{"$unset": bson.M{"archived_at": ""}})
+	return err
+}
+
+// DeleteByEventIDs elimina definitivamente los comentarios de los eventos indicados
+func (r *commentRepository) DeleteByEventIDs(ctx context.Context, eventIDs []primitive.ObjectID) (int64, error) {
+	result, err := r.collection.DeleteMany(ctx, bson.M{"event_id": bson.M{"$in": eventIDs}})
+	if err != nil {
+		return 0, err
+	}
+
+	return result.DeletedCount, nil
+}
@@ -0,0 +1,188 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"events-api/internal/apierror"
+	"events-api/internal/config"
+	"events-api/internal/models"
+	"events-api/pkg/database"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// EscalationPolicyRepository define las operaciones del repositorio de políticas de escalado
+type EscalationPolicyRepository interface {
+	FindAll(ctx context.Context) ([]models.EscalationPolicy, error)
+	FindEnabled(ctx context.Context) ([]models.EscalationPolicy, error)
+	FindByID(ctx context.Context, id string) (models.EscalationPolicy, error)
+	Create(ctx context.Context, policy models.EscalationPolicy) (models.EscalationPolicy, error)
+	Update(ctx context.Context, id string, policy models.EscalationPolicy) (models.EscalationPolicy, error)
+	Delete(ctx context.Context, id string) error
+	ExistsByType(ctx context.Context, eventType models.EventType) (bool, error)
+}
+
+// escalationPolicyRepository implementa EscalationPolicyRepository
+type escalationPolicyRepository struct {
+	collection *mongo.Collection
+}
+
+// NewEscalationPolicyRepository crea una nueva instancia de EscalationPolicyRepository y asegura sus índices
+func NewEscalationPolicyRepository(client *mongo.Client, cfg *config.Config) (EscalationPolicyRepository, error) {
+	collection := database.GetCollection(client, cfg, cfg.EscalationCollection)
+	repository := &escalationPolicyRepository{
+		collection: collection,
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	// Cada tipo de evento tiene como máximo una cadena de escalado
+	_, err := collection.Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys:    bson.D{{Key: "event_type", Value: 1}},
+		Options: options.Index().SetName("escalation_event_type").SetUnique(true),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return repository, nil
+}
+
+// FindAll recupera todas las políticas por tipo de evento
+func (r *escalationPolicyRepository) FindAll(ctx context.Context) ([]models.EscalationPolicy, error) {
+	return r.find(ctx, bson.M{})
+}
+
+// FindEnabled recupera las políticas habilitadas por tipo de evento
+func (r *escalationPolicyRepository) FindEnabled(ctx context.Context) ([]models.EscalationPolicy, error) {
+	return r.find(ctx, bson.M{"enabled": true})
+}
+
+// FindByID recupera una política por su ID
+func (r *escalationPolicyRepository) FindByID(ctx context.Context, id string) (models.EscalationPolicy, error) {
+	objectID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return models.EscalationPolicy{}, apierror.NewError(apierror.BadRequest, "ID de política de escalado inválido")
+	}
+
+	var policy models.EscalationPolicy
+	err = r.collection.FindOne(ctx, bson.M{"_id": objectID}).Decode(&policy)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return models.EscalationPolicy{}, apierror.NewError(apierror.NotFound, "política de escalado no encontrada")
+		}
+		return models.EscalationPolicy{}, apierror.NewError(apierror.Internal, "error al buscar la política de escalado: "+err.Error())
+	}
+
+	return policy, nil
+}
+
+// Create crea una nueva política
+func (r *escalationPolicyRepository) Create(ctx context.Context, policy models.EscalationPolicy) (models.EscalationPolicy, error) {
+	now := time.Now()
+
+	policy.CreatedAt = now
+	policy.UpdatedAt = now
+
+	if policy.ID.IsZero() {
+		policy.ID = primitive.NewObjectID()
+	}
+
+	_, err := r.collection.InsertOne(ctx, policy)
+	if err != nil {
+		if mongo.IsDuplicateKeyError(err) {
+			return models.EscalationPolicy{}, duplicateEscalationPolicy(policy.EventType)
+		}
+		return models.EscalationPolicy{}, apierror.NewError(apierror.Internal, "error al crear la política de escalado: "+err.Error())
+	}
+
+	return policy, nil
+}
+
+// Update actualiza una política existente
+func (r *escalationPolicyRepository) Update(ctx context.Context, id string, policy models.EscalationPolicy) (models.EscalationPolicy, error) {
+	objectID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return models.EscalationPolicy{}, apierror.NewError(apierror.BadRequest, "ID de política de escalado inválido")
+	}
+
+	update := bson.M{
+		"$set": bson.M{
+			"name":       policy.Name,
+			"event_type": policy.EventType,
+			"enabled":    policy.Enabled,
+			"levels":     policy.Levels,
+			"updated_at": time.Now(),
+		},
+	}
+
+	result, err := r.collection.UpdateOne(ctx, bson.M{"_id": objectID}, update)
+	if err != nil {
+		if mongo.IsDuplicateKeyError(err) {
+			return models.EscalationPolicy{}, duplicateEscalationPolicy(policy.EventType)
+		}
+		return models.EscalationPolicy{}, apierror.NewError(apierror.Internal, "error al actualizar la política de escalado: "+err.Error())
+	}
+
+	if result.MatchedCount == 0 {
+		return models.EscalationPolicy{}, apierror.NewError(apierror.NotFound, "política de escalado no encontrada")
+	}
+
+	return r.FindByID(ctx, id)
+}
+
+// ExistsByType indica si alguna política de escalado se aplica al tipo de evento indicado
+func (r *escalationPolicyRepository) ExistsByType(ctx context.Context, eventType models.EventType) (bool, error) {
+	count, err := r.collection.CountDocuments(ctx, bson.M{"event_type": eventType}, options.Count().SetLimit(1))
+	if err != nil {
+		return false, apierror.NewError(apierror.Internal, "error al buscar las políticas de escalado del tipo: "+err.Error())
+	}
+
+	return count > 0, nil
+}
+
+// Delete elimina una política. Los eventos ya escalados conservan su nivel de escalado.
+func (r *escalationPolicyRepository) Delete(ctx context.Context, id string) error {
+	objectID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return apierror.NewError(apierror.BadRequest, "ID de política de escalado inválido")
+	}
+
+	result, err := r.collection.DeleteOne(ctx, bson.M{"_id": objectID})
+	if err != nil {
+		return apierror.NewError(apierror.Internal, "error al eliminar la política de escalado: "+err.Error())
+	}
+
+	if result.DeletedCount == 0 {
+		return apierror.NewError(apierror.NotFound, "política de escalado no encontrada")
+	}
+
+	return nil
+}
+
+// find recupera las políticas que cumplen el filtro ordenadas por tipo de evento
+func (r *escalationPolicyRepository) find(ctx context.Context, filter bson.M) ([]models.EscalationPolicy, error) {
+	cursor, err := r.collection.Find(ctx, filter, options.Find().SetSort(bson.M{"event_type": 1}))
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var policies []models.EscalationPolicy
+	if err := cursor.All(ctx, &policies); err != nil {
+		return nil, err
+	}
+
+	return policies, nil
+}
+
+// duplicateEscalationPolicy construye el error de una política para un tipo que ya tiene otra
+func duplicateEscalationPolicy(eventType models.EventType) error {
+	return apierror.NewError(apierror.ResourceExists, "ya existe una política de escalado para el tipo "+string(eventType))
+}
@@ -3,6 +3,8 @@ package repositories
 import (
 	"context"
 	"errors"
+	"regexp"
+	"strconv"
 	"time"
 
 	"events-api/internal/apierror"
@@ -18,14 +20,38 @@ import (
 
 // EventRepository define las operaciones del repositorio de eventos
 type EventRepository interface {
-	FindAll(ctx context.Context) ([]models.Event, error)
+	FindAll(ctx context.Context, opts models.ListOptions) ([]models.Event, int64, error)
+	FindByFilter(ctx context.Context, filter models.EventFilter, opts models.ListOptions) ([]models.Event, int64, error)
+	Search(ctx context.Context, text string, filter models.EventFilter, opts models.ListOptions) ([]models.Event, int64, error)
+	FindQueue(ctx context.Context, opts models.ListOptions) ([]models.Event, int64, error)
+	FindOverdue(ctx context.Context, now time.Time, opts models.ListOptions) ([]models.Event, int64, error)
+	FlagSLABreaches(ctx context.Context, now time.Time) (int64, error)
+	FindUnhandled(ctx context.Context, eventTypes []models.EventType, now time.Time, limit int) ([]models.Event, error)
+	Escalate(ctx context.Context, event models.Event, escalation models.EventEscalation, dueAt *time.Time) (models.Event, bool, error)
+	SetEscalationDue(ctx context.Context, event models.Event, dueAt *time.Time) error
+	ResetEscalationDue(ctx context.Context, eventType models.EventType) error
+	ClearTeam(ctx context.Context, teamID string) error
+	IncrementCommentCount(ctx context.Context, id primitive.ObjectID, delta int) error
+	TagCounts(ctx context.Context, prefix string, limit int) ([]models.TagCount, error)
+	ExistsByType(ctx context.Context, eventType models.EventType) (bool, error)
+	AddAttachment(ctx context.Context, id primitive.ObjectID, attachment models.Attachment) (models.Event, error)
+	RemoveAttachment(ctx context.Context, id primitive.ObjectID, attachmentID primitive.ObjectID) (models.Event, error)
+	FindPurgeable(ctx context.Context, deletedBefore time.Time, limit int) ([]models.Event, error)
+	Stats(ctx context.Context, filter models.EventFilter, opts models.EventStatsOptions) (models.EventStats, error)
 	FindByID(ctx context.Context, id string) (models.Event, error)
+	FindByIDs(ctx context.Context, ids []string) ([]models.Event, error)
 	Create(ctx context.Context, event models.Event) (models.Event, error)
 	Update(ctx context.Context, id string, event models.Event) (models.Event, error)
-	Delete(ctx context.Context, id string) error
+	Patch(ctx context.Context, id string, version int64, fields map[string]interface{}) (models.Event, error)
+	Delete(ctx context.Context, id string, version int64) (models.Event, error)
+	FindDeleted(ctx context.Context, opts models.ListOptions) ([]models.Event, int64, error)
+	FindDeletedByID(ctx context.Context, id string) (models.Event, error)
+	Restore(ctx context.Context, id string) (models.Event, error)
+	PurgeDeleted(ctx context.Context, ids []primitive.ObjectID, deletedBefore time.Time) ([]primitive.ObjectID, error)
 	FindByStatus(ctx context.Context, status models.EventStatus) ([]models.Event, error)
 	FindByManagementStatus(ctx context.Context, managementStatus models.ManagementStatus) ([]models.Event, error)
-	BulkInsert(ctx context.Context, events []models.Event) error
+	BulkInsert(ctx context.Context, events []models.Event) ([]error, error)
+	BulkUpdate(ctx context.Context, events []models.Event, atomic bool) ([]models.Event, []error, error)
 }
 
 // eventRepository implementa EventRepository
@@ -33,19 +59,374 @@ type eventRepository struct {
 	collection *mongo.Collection
 }
 
-// NewEventRepository crea una nueva instancia de EventRepository
-func NewEventRepository(client *mongo.Client, cfg *config.Config) EventRepository {
+// NewEventRepository crea una nueva instancia de EventRepository y asegura sus índices
+func NewEventRepository(client *mongo.Client, cfg *config.Config) (EventRepository, error) {
 	collection := database.GetCollection(client, cfg, cfg.EventsCollection)
-	return &eventRepository{
+	repository := &eventRepository{
 		collection: collection,
 	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	if err := repository.ensureIndexes(ctx); err != nil {
+		return nil, err
+	}
+
+	if err := runMigration(ctx, client, cfg, "events_backfill_priorities", repository.backfillPriorities); err != nil {
+		return nil, err
+	}
+
+	return repository, nil
 }
 
-// FindAll recupera todos los eventos
-func (r *eventRepository) FindAll(ctx context.Context) ([]models.Event, error) {
-	opts := options.Find().SetSort(bson.D{{Key: "created_at", Value: -1}})
+// ensureIndexes crea los índices necesarios para las consultas de eventos
+func (r *eventRepository) ensureIndexes(ctx context.Context) error {
+	indexes := []mongo.IndexModel{
+		{
+			// Índice de texto para la búsqueda por nombre y descripción (contenido en español)
+			Keys: bson.D{{Key: "name", Value: "text"}, {Key: "description", Value: "text"}},
+			Options: options.Index().
+				SetName("events_text").
+				SetDefaultLanguage("spanish").
+				SetWeights(bson.D{{Key: "name", Value: 3}, {Key: "description", Value: 1}}),
+		},
+		{
+			// Índice disperso para la papelera y su purga
+			Keys:    bson.D{{Key: "deleted_at", Value: 1}},
+			Options: options.Index().SetName("events_deleted_at").SetSparse(true),
+		},
+		{
+			// Índice compuesto para el orden por defecto y la paginación por cursor
+			Keys:    bson.D{{Key: "created_at", Value: -1}, {Key: "_id", Value: -1}},
+			Options: options.Index().SetName("events_created_at_id"),
+		},
+		{
+			// Índice compuesto para la cola de triaje: eventos pendientes por prioridad y antigüedad
+			Keys:    bson.D{{Key: "status", Value: 1}, {Key: "priority", Value: 1}, {Key: "created_at", Value: 1}, {Key: "_id", Value: 1}},
+			Options: options.Index().SetName("events_queue"),
+		},
+		{
+			// Índice compuesto para las vistas por responsable
+			Keys:    bson.D{{Key: "assignee_id", Value: 1}, {Key: "created_at", Value: -1}},
+			Options: options.Index().SetName("events_assignee"),
+		},
+		{
+			// Filtro por equipo y limpieza de los eventos de un equipo eliminado
+			Keys:    bson.D{{Key: "team_id", Value: 1}, {Key: "created_at", Value: -1}},
+			Options: options.Index().SetName("events_team"),
+		},
+		{
+			// Índices compuestos para los eventos con plazos vencidos y su detección
+			Keys:    bson.D{{Key: "status", Value: 1}, {Key: "review_due_at", Value: 1}},
+			Options: options.Index().SetName("events_review_due"),
+		},
+		{
+			Keys:    bson.D{{Key: "status", Value: 1}, {Key: "resolve_due_at", Value: 1}},
+			Options: options.Index().SetName("events_resolve_due"),
+		},
+		{
+			// Índice para los eventos cuyo escalado debe evaluarse
+			Keys:    bson.D{{Key: "escalation_due_at", Value: 1}, {Key: "type", Value: 1}},
+			Options: options.Index().SetName("events_escalation_due"),
+		},
+		{
+			// Índice multiclave para el filtro por etiquetas y su autocompletado
+			Keys:    bson.D{{Key: "tags", Value: 1}, {Key: "created_at", Value: -1}},
+			Options: options.Index().SetName("events_tags"),
+		},
+		{
+			// Índice comodín para el filtro por cualquier clave de atributo
+			Keys:    bson.D{{Key: "labels.$**", Value: 1}},
+			Options: options.Index().SetName("events_labels"),
+		},
+		{
+			// Índice comodín para el filtro por cualquier ruta de los campos personalizados
+			Keys:    bson.D{{Key: "attributes.$**", Value: 1}},
+			Options: options.Index().SetName("events_attributes"),
+		},
+	}
+
+	_, err := r.collection.Indexes().CreateMany(ctx, indexes)
+	return err
+}
+
+// backfillPriorities asigna la prioridad y la severidad derivadas del tipo a los eventos creados
+// antes de que existieran estos campos. Se aplica una sola vez como migración.
+func (r *eventRepository) backfillPriorities(ctx context.Context) error {
+	priorityBranches := bson.A{}
+	severityBranches := bson.A{}
+	for _, eventType := range models.BuiltinEventTypes {
+		priorityBranches = append(priorityBranches, bson.M{"case": bson.M{"$eq": bson.A{"$type", eventType.Code}}, "then": eventType.DefaultPriority})
+		severityBranches = append(severityBranches, bson.M{"case": bson.M{"$eq": bson.A{"$type", eventType.Code}}, "then": eventType.DefaultSeverity})
+	}
+
+	update := mongo.Pipeline{
+		{{Key: "$set", Value: bson.M{
+			"priority": bson.M{"$switch": bson.M{"branches": priorityBranches, "default": models.PriorityP4}},
+			"severity": bson.M{"$switch": bson.M{"branches": severityBranches, "default": models.MinSeverity}},
+		}}},
+	}
+
+	_, err := r.collection.UpdateMany(ctx, bson.M{"priority": bson.M{"$exists": false}}, update)
+	return err
+}
+
+// FindAll recupera una página de eventos junto al total de documentos
+func (r *eventRepository) FindAll(ctx context.Context, opts models.ListOptions) ([]models.Event, int64, error) {
+	return r.FindByFilter(ctx, models.EventFilter{}, opts)
+}
+
+// FindByFilter recupera una página de eventos que cumplen el filtro junto al total de coincidencias
+func (r *eventRepository) FindByFilter(ctx context.Context, filter models.EventFilter, opts models.ListOptions) ([]models.Event, int64, error) {
+	findOpts := options.Find().SetSort(buildSort(opts.Sort))
+
+	return r.findPage(ctx, buildEventFilter(filter), findOpts, opts)
+}
+
+// Search recupera una página de eventos cuyo nombre o descripción coinciden con el texto,
+// ordenados por relevancia y combinados con el filtro indicado
+func (r *eventRepository) Search(ctx context.Context, text string, filter models.EventFilter, opts models.ListOptions) ([]models.Event, int64, error) {
+	query := buildEventFilter(filter)
+	query["$text"] = bson.M{"$search": text}
+
+	score := bson.M{"$meta": "textScore"}
+	sort := append(bson.D{{Key: "score", Value: score}}, buildSort(opts.Sort)...)
+
+	findOpts := options.Find().
+		SetProjection(bson.M{"score": score}).
+		SetSort(sort)
+
+	return r.findPage(ctx, query, findOpts, opts)
+}
+
+// FindQueue recupera una página de la cola de triaje: los eventos pendientes ordenados por
+// prioridad y, dentro de la misma prioridad, del más antiguo al más reciente
+func (r *eventRepository) FindQueue(ctx context.Context, opts models.ListOptions) ([]models.Event, int64, error) {
+	query := bson.M{"status": models.StatusPending, "deleted_at": nil}
+
+	findOpts := options.Find().SetSort(bson.D{
+		{Key: "priority", Value: 1},
+		{Key: "created_at", Value: 1},
+		{Key: "_id", Value: 1},
+	})
+
+	return r.findPage(ctx, query, findOpts, opts)
+}
+
+// FindOverdue recupera una página de los eventos cuyo plazo vigente ha vencido en el instante
+// indicado: los pendientes fuera del plazo de revisión y los que se están resolviendo fuera del
+// plazo de resolución, del más antiguo al más reciente
+func (r *eventRepository) FindOverdue(ctx context.Context, now time.Time, opts models.ListOptions) ([]models.Event, int64, error) {
+	query := bson.M{
+		"$or":        overdueFilters(now),
+		"deleted_at": nil,
+	}
+
+	findOpts := options.Find().SetSort(bson.D{
+		{Key: "created_at", Value: 1},
+		{Key: "_id", Value: 1},
+	})
+
+	return r.findPage(ctx, query, findOpts, opts)
+}
+
+// FlagSLABreaches registra el instante indicado como momento del incumplimiento en los eventos
+// cuyo plazo vigente ha vencido y que aún no estaban marcados. La marca no cambia la versión del
+// evento, ya que no es una modificación del cliente.
+func (r *eventRepository) FlagSLABreaches(ctx context.Context, now time.Time) (int64, error) {
+	filters := overdueFilters(now)
+	fields := []string{"review_breached_at", "resolve_breached_at"}
+
+	var flagged int64
+	for i, filter := range filters {
+		filter[fields[i]] = nil
+		filter["deleted_at"] = nil
+
+		result, err := r.collection.UpdateMany(ctx, filter, bson.M{"$set": bson.M{fields[i]: now}})
+		if err != nil {
+			return flagged, err
+		}
+		flagged += result.ModifiedCount
+	}
+
+	return flagged, nil
+}
 
-	cursor, err := r.collection.Find(ctx, bson.M{}, opts)
+// overdueFilters devuelve las condiciones de los eventos fuera del plazo de revisión y fuera
+// del plazo de resolución, en ese orden
+func overdueFilters(now time.Time) []bson.M {
+	return []bson.M{
+		{"status": models.StatusPending, "review_due_at": bson.M{"$lt": now}},
+		{"status": bson.M{"$in": models.ResolvingStatuses}, "resolve_due_at": bson.M{"$lt": now}},
+	}
+}
+
+// FindUnhandled recupera como máximo limit eventos sin atender de los tipos indicados cuyo escalado
+// debe evaluarse: los que nunca se han evaluado, o se han modificado desde la última evaluación,
+// y los que tienen un nivel de escalado vencido en el instante indicado
+func (r *eventRepository) FindUnhandled(ctx context.Context, eventTypes []models.EventType, now time.Time, limit int) ([]models.Event, error) {
+	query := unhandledFilter()
+	query["type"] = bson.M{"$in": eventTypes}
+	query["$and"] = bson.A{
+		bson.M{"$or": bson.A{
+			bson.M{"escalation_due_at": bson.M{"$exists": false}},
+			bson.M{"escalation_due_at": bson.M{"$lte": now}},
+		}},
+	}
+
+	opts := options.Find().
+		SetSort(bson.D{{Key: "escalation_due_at", Value: 1}, {Key: "_id", Value: 1}}).
+		SetLimit(int64(limit))
+
+	return r.findEvents(ctx, query, opts)
+}
+
+// Escalate guarda el nuevo escalado de un evento siempre que siga sin atender y no haya cambiado
+// desde que se leyó, junto al instante en que vence su siguiente nivel. El escalado no cambia la
+// versión del evento, ya que no es una modificación del cliente. Devuelve el evento actualizado e
+// indica si se ha aplicado.
+func (r *eventRepository) Escalate(ctx context.Context, event models.Event, escalation models.EventEscalation, dueAt *time.Time) (models.Event, bool, error) {
+	filter := unhandledFilter()
+	filter["_id"] = event.ID
+	filter["version"] = versionFilter(event.Version)
+	if event.Escalation != nil {
+		filter["escalation.escalated_at"] = event.Escalation.EscalatedAt
+	} else {
+		filter["escalation"] = nil
+	}
+
+	update := bson.M{"$set": bson.M{"escalation": escalation, "escalation_due_at": dueAt}}
+	opts := options.FindOneAndUpdate().SetReturnDocument(options.After)
+
+	var updated models.Event
+	err := r.collection.FindOneAndUpdate(ctx, filter, update, opts).Decode(&updated)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return models.Event{}, false, nil
+		}
+		return models.Event{}, false, apierror.NewError(apierror.Internal, "error al escalar el evento: "+err.Error())
+	}
+
+	return updated, true, nil
+}
+
+// SetEscalationDue guarda el instante en que vence el siguiente nivel de escalado de un evento,
+// o null si su cadena de escalado ha terminado, siempre que el evento no haya cambiado desde que
+// se leyó
+func (r *eventRepository) SetEscalationDue(ctx context.Context, event models.Event, dueAt *time.Time) error {
+	filter := bson.M{"_id": event.ID, "version": versionFilter(event.Version)}
+
+	_, err := r.collection.UpdateOne(ctx, filter, bson.M{"$set": bson.M{"escalation_due_at": dueAt}})
+	if err != nil {
+		return apierror.NewError(apierror.Internal, "error al guardar el vencimiento del escalado: "+err.Error())
+	}
+
+	return nil
+}
+
+// ResetEscalationDue elimina el vencimiento del escalado de los eventos de un tipo para que se
+// evalúen de nuevo, por ejemplo al cambiar su política de escalado
+func (r *eventRepository) ResetEscalationDue(ctx context.Context, eventType models.EventType) error {
+	filter := bson.M{"type": eventType, "escalation_due_at": bson.M{"$exists": true}}
+
+	_, err := r.collection.UpdateMany(ctx, filter, bson.M{"$unset": bson.M{"escalation_due_at": ""}})
+	if err != nil {
+		return apierror.NewError(apierror.Internal, "error al restablecer el escalado de los eventos: "+err.Error())
+	}
+
+	return nil
+}
+
+// ClearTeam quita el equipo de los eventos asignados a él, por ejemplo al eliminarlo. Los eventos
+// conservan su responsable.
+func (r *eventRepository) ClearTeam(ctx context.Context, teamID string) error {
+	update := bson.M{
+		"$unset": bson.M{"team_id": "", "escalation_due_at": ""},
+		"$set":   bson.M{"updated_at": time.Now()},
+		"$inc":   bson.M{"version": 1},
+	}
+
+	_, err := r.collection.UpdateMany(ctx, bson.M{"team_id": teamID}, update)
+	if err != nil {
+		return apierror.NewError(apierror.Internal, "error al quitar el equipo de los eventos: "+err.Error())
+	}
+
+	return nil
+}
+
+// IncrementCommentCount suma delta al número de comentarios de un evento. El contador no cambia
+// la versión del evento, ya que los comentarios no forman parte de él.
+func (r *eventRepository) IncrementCommentCount(ctx context.Context, id primitive.ObjectID, delta int) error {
+	_, err := r.collection.UpdateOne(ctx, bson.M{"_id": id}, bson.M{"$inc": bson.M{"comment_count": delta}})
+	return err
+}
+
+// AddAttachment añade los metadatos de un archivo adjunto a un evento no eliminado. Como el
+// contador de comentarios, los adjuntos no cambian la versión del evento. El límite de adjuntos
+// forma parte de la condición de la escritura para que no lo superen subidas simultáneas.
+func (r *eventRepository) AddAttachment(ctx context.Context, id primitive.ObjectID, attachment models.Attachment) (models.Event, error) {
+	filter := bson.M{
+		"_id":        id,
+		"deleted_at": nil,
+		"attachments." + strconv.Itoa(models.MaxAttachmentsPerEvent-1): bson.M{"$exists": false},
+	}
+	update := bson.M{
+		"$push": bson.M{"attachments": attachment},
+		"$set":  bson.M{"updated_at": time.Now()},
+	}
+
+	event, err := r.updateAttachments(ctx, filter, update, "evento no encontrado")
+	if apiErr, ok := apierror.AsError(err); ok && apiErr.Type == apierror.NotFound {
+		// El evento existe pero ya tiene el máximo de adjuntos
+		count, countErr := r.collection.CountDocuments(ctx, bson.M{"_id": id, "deleted_at": nil})
+		if countErr == nil && count > 0 {
+			return models.Event{}, apierror.NewError(apierror.ValidationFail, "el evento admite como máximo "+strconv.Itoa(models.MaxAttachmentsPerEvent)+" archivos adjuntos")
+		}
+	}
+
+	return event, err
+}
+
+// RemoveAttachment elimina los metadatos de un archivo adjunto de un evento no eliminado
+func (r *eventRepository) RemoveAttachment(ctx context.Context, id primitive.ObjectID, attachmentID primitive.ObjectID) (models.Event, error) {
+	filter := bson.M{"_id": id, "deleted_at": nil, "attachments._id": attachmentID}
+	update := bson.M{
+		"$pull": bson.M{"attachments": bson.M{"_id": attachmentID}},
+		"$set":  bson.M{"updated_at": time.Now()},
+	}
+
+	return r.updateAttachments(ctx, filter, update, "archivo adjunto no encontrado")
+}
+
+// updateAttachments aplica una actualización de los adjuntos y devuelve el evento actualizado
+func (r *eventRepository) updateAttachments(ctx context.Context, filter bson.M, update bson.M, notFound string) (models.Event, error) {
+	opts := options.FindOneAndUpdate().SetReturnDocument(options.After)
+
+	var event models.Event
+	err := r.collection.FindOneAndUpdate(ctx, filter, update, opts).Decode(&event)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return models.Event{}, apierror.NewError(apierror.NotFound, notFound)
+		}
+		return models.Event{}, apierror.NewError(apierror.Internal, "error al actualizar los adjuntos del evento: "+err.Error())
+	}
+
+	return event, nil
+}
+
+// FindPurgeable recupera el identificador y los adjuntos de como máximo limit eventos enviados a
+// la papelera antes de la fecha indicada, del borrado más antiguo al más reciente, que se
+// eliminarán en la siguiente purga
+func (r *eventRepository) FindPurgeable(ctx context.Context, deletedBefore time.Time, limit int) ([]models.Event, error) {
+	filter := bson.M{"deleted_at": bson.M{"$lte": deletedBefore}}
+
+	opts := options.Find().
+		SetProjection(bson.M{"attachments": 1}).
+		SetSort(bson.D{{Key: "deleted_at", Value: 1}, {Key: "_id", Value: 1}}).
+		SetLimit(int64(limit))
+
+	cursor, err := r.collection.Find(ctx, filter, opts)
 	if err != nil {
 		return nil, err
 	}
@@ -59,6 +440,220 @@ func (r *eventRepository) FindAll(ctx context.Context) ([]models.Event, error) {
 	return events, nil
 }
 
+// TagCounts recupera las etiquetas de los eventos no eliminados que comienzan por el prefijo
+// indicado, de la más usada a la menos usada
+func (r *eventRepository) TagCounts(ctx context.Context, prefix string, limit int) ([]models.TagCount, error) {
+	match := bson.M{"deleted_at": nil}
+	if prefix != "" {
+		// El prefijo anclado permite usar el índice de etiquetas
+		match["tags"] = primitive.Regex{Pattern: "^" + regexp.QuoteMeta(prefix)}
+	}
+
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: match}},
+		{{Key: "$unwind", Value: "$tags"}},
+	}
+
+	if prefix != "" {
+		// Un evento con una etiqueta que coincide puede tener otras que no coinciden
+		pipeline = append(pipeline, bson.D{{Key: "$match", Value: bson.M{"tags": match["tags"]}}})
+	}
+
+	pipeline = append(pipeline,
+		bson.D{{Key: "$group", Value: bson.M{"_id": "$tags", "count": bson.M{"$sum": 1}}}},
+		bson.D{{Key: "$sort", Value: bson.D{{Key: "count", Value: -1}, {Key: "_id", Value: 1}}}},
+		bson.D{{Key: "$limit", Value: limit}},
+	)
+
+	cursor, err := r.collection.Aggregate(ctx, pipeline)
+	if err != nil {
+		return nil, apierror.NewError(apierror.Internal, "error al contar las etiquetas: "+err.Error())
+	}
+	defer cursor.Close(ctx)
+
+	var tags []models.TagCount
+	if err := cursor.All(ctx, &tags); err != nil {
+		return nil, apierror.NewError(apierror.Internal, "error al decodificar las etiquetas: "+err.Error())
+	}
+
+	return tags, nil
+}
+
+// ExistsByType indica si algún evento, incluidos los de la papelera, es del tipo indicado
+func (r *eventRepository) ExistsByType(ctx context.Context, eventType models.EventType) (bool, error) {
+	count, err := r.collection.CountDocuments(ctx, bson.M{"type": eventType}, options.Count().SetLimit(1))
+	if err != nil {
+		return false, apierror.NewError(apierror.Internal, "error al buscar los eventos del tipo: "+err.Error())
+	}
+
+	return count > 0, nil
+}
+
+// unhandledFilter devuelve la condición de los eventos sin atender
+func unhandledFilter() bson.M {
+	return bson.M{
+		"deleted_at": nil,
+		"$or": bson.A{
+			bson.M{"status": models.StatusPending},
+			bson.M{
+				"status":            bson.M{"$in": bson.A{models.StatusReviewed, models.StatusReopened}},
+				"management_status": models.ManagementRequired,
+			},
+		},
+	}
+}
+
+// Stats calcula los conteos por dimensión y el histograma de los eventos que cumplen el filtro
+func (r *eventRepository) Stats(ctx context.Context, filter models.EventFilter, opts models.EventStatsOptions) (models.EventStats, error) {
+	facets := bson.M{
+		"total": bson.A{bson.M{"$count": "count"}},
+	}
+
+	for _, group := range opts.GroupBy {
+		facets[string(group)] = bson.A{
+			bson.M{"$group": bson.M{"_id": statsGroupKeys[group], "count": bson.M{"$sum": 1}}},
+			bson.M{"$sort": bson.D{{Key: "count", Value: -1}, {Key: "_id", Value: 1}}},
+		}
+	}
+
+	if opts.Interval != "" {
+		facets["histogram"] = bson.A{
+			bson.M{"$group": bson.M{
+				"_id": bson.M{"$dateToString": bson.M{
+					"format": statsIntervalFormats[opts.Interval],
+					"date":   "$" + statsDateFields[opts.DateField],
+				}},
+				"count": bson.M{"$sum": 1},
+			}},
+			bson.M{"$sort": bson.M{"_id": 1}},
+		}
+	}
+
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: buildEventFilter(filter)}},
+		{{Key: "$facet", Value: facets}},
+	}
+
+	cursor, err := r.collection.Aggregate(ctx, pipeline)
+	if err != nil {
+		return models.EventStats{}, apierror.NewError(apierror.Internal, "error al calcular las estadísticas: "+err.Error())
+	}
+	defer cursor.Close(ctx)
+
+	var results []statsFacetResult
+	if err := cursor.All(ctx, &results); err != nil {
+		return models.EventStats{}, apierror.NewError(apierror.Internal, "error al calcular las estadísticas: "+err.Error())
+	}
+
+	stats := models.EventStats{}
+	if len(results) == 0 {
+		return stats, nil
+	}
+
+	result := results[0]
+	if len(result.Total) > 0 {
+		stats.Total = result.Total[0].Count
+	}
+	stats.ByType = toCountBuckets(result.ByType)
+	stats.ByStatus = toCountBuckets(result.ByStatus)
+	stats.ByManagementStatus = toCountBuckets(result.ByManagementStatus)
+
+	if opts.Interval != "" {
+		stats.Interval = opts.Interval
+		stats.DateField = opts.DateField
+		stats.Histogram = make([]models.HistogramBucket, 0, len(result.Histogram))
+		for _, bucket := range result.Histogram {
+			stats.Histogram = append(stats.Histogram, models.HistogramBucket{Period: bucket.Key, Count: bucket.Count})
+		}
+	}
+
+	return stats, nil
+}
+
+// statsGroupKeys relaciona cada dimensión de agrupación con su expresión de agrupación. Los
+// eventos sin estado de gestión, ya sea porque falta, es nulo o quedó vacío al deshacer su
+// revisión, se agrupan en NONE.
+var statsGroupKeys = map[models.StatsGroup]interface{}{
+	models.GroupByType:   "$type",
+	models.GroupByStatus: "$status",
+	models.GroupByManagementStatus: bson.M{"$cond": bson.A{
+		bson.M{"$in": bson.A{bson.M{"$ifNull": bson.A{"$management_status", nil}}, bson.A{nil, ""}}},
+		models.StatsNoManagementStatus,
+		"$management_status",
+	}},
+}
+
+// statsIntervalFormats relaciona cada intervalo con el formato de fecha que identifica el periodo
+var statsIntervalFormats = map[models.StatsInterval]string{
+	models.IntervalDay:   "%Y-%m-%d",
+	models.IntervalWeek:  "%G-W%V",
+	models.IntervalMonth: "%Y-%m",
+}
+
+// statsDateFields relaciona cada campo de fecha con su campo en MongoDB
+var statsDateFields = map[models.StatsDateField]string{
+	models.StatsDateFieldDate:      "date",
+	models.StatsDateFieldCreatedAt: "created_at",
+}
+
+// statsBucket representa un grupo devuelto por la agregación de estadísticas
+type statsBucket struct {
+	Key   string `bson:"_id"`
+	Count int64  `bson:"count"`
+}
+
+// statsFacetResult representa el documento devuelto por la etapa $facet de las estadísticas
+type statsFacetResult struct {
+	Total []struct {
+		Count int64 `bson:"count"`
+	} `bson:"total"`
+	ByType             []statsBucket `bson:"type"`
+	ByStatus           []statsBucket `bson:"status"`
+	ByManagementStatus []statsBucket `bson:"managementStatus"`
+	Histogram          []statsBucket `bson:"histogram"`
+}
+
+// toCountBuckets convierte los grupos de la agregación en CountBucket
+func toCountBuckets(buckets []statsBucket) []models.CountBucket {
+	if buckets == nil {
+		return nil
+	}
+
+	counts := make([]models.CountBucket, 0, len(buckets))
+	for _, bucket := range buckets {
+		counts = append(counts, models.CountBucket{Key: bucket.Key, Count: bucket.Count})
+	}
+	return counts
+}
+
+// findPage ejecuta una consulta paginada y devuelve los eventos junto al total de coincidencias
+func (r *eventRepository) findPage(ctx context.Context, query bson.M, findOpts *options.FindOptions, opts models.ListOptions) ([]models.Event, int64, error) {
+	total, err := r.collection.CountDocuments(ctx, query)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	// La condición del cursor solo limita la página, no el total de coincidencias
+	if opts.Cursor != nil {
+		query = bson.M{"$and": bson.A{query, buildCursorFilter(*opts.Cursor)}}
+	}
+
+	findOpts.SetSkip(opts.Skip()).SetLimit(opts.PageSize)
+
+	cursor, err := r.collection.Find(ctx, query, findOpts)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer cursor.Close(ctx)
+
+	var events []models.Event
+	if err := cursor.All(ctx, &events); err != nil {
+		return nil, 0, err
+	}
+
+	return events, total, nil
+}
+
 // FindByID recupera un evento por su ID
 func (r *eventRepository) FindByID(ctx context.Context, id string) (models.Event, error) {
 	objectID, err := primitive.ObjectIDFromHex(id)
@@ -67,7 +662,7 @@ func (r *eventRepository) FindByID(ctx context.Context, id string) (models.Event
 	}
 
 	var event models.Event
-	err = r.collection.FindOne(ctx, bson.M{"_id": objectID}).Decode(&event)
+	err = r.collection.FindOne(ctx, bson.M{"_id": objectID, "deleted_at": nil}).Decode(&event)
 	if err != nil {
 		if errors.Is(err, mongo.ErrNoDocuments) {
 			return models.Event{}, apierror.NewError(apierror.NotFound, "evento no encontrado")
@@ -78,6 +673,19 @@ func (r *eventRepository) FindByID(ctx context.Context, id string) (models.Event
 	return event, nil
 }
 
+// FindByIDs recupera los eventos no eliminados con los IDs indicados. Los IDs inválidos o
+// inexistentes se ignoran.
+func (r *eventRepository) FindByIDs(ctx context.Context, ids []string) ([]models.Event, error) {
+	objectIDs := make([]primitive.ObjectID, 0, len(ids))
+	for _, id := range ids {
+		if objectID, err := primitive.ObjectIDFromHex(id); err == nil {
+			objectIDs = append(objectIDs, objectID)
+		}
+	}
+
+	return r.findEvents(ctx, bson.M{"_id": bson.M{"$in": objectIDs}, "deleted_at": nil})
+}
+
 // Create crea un nuevo evento
 func (r *eventRepository) Create(ctx context.Context, event models.Event) (models.Event, error) {
 	now := time.Now()
@@ -85,6 +693,7 @@ func (r *eventRepository) Create(ctx context.Context, event models.Event) (model
 	event.CreatedAt = now
 	event.UpdatedAt = now
 	event.Status = models.StatusPending
+	event.Version = 1
 
 	if event.ID.IsZero() {
 		event.ID = primitive.NewObjectID()
@@ -98,7 +707,8 @@ func (r *eventRepository) Create(ctx context.Context, event models.Event) (model
 	return event, nil
 }
 
-// Update actualiza un evento existente
+// Update actualiza un evento existente. La actualización solo se aplica si el evento
+// conserva la versión con la que se leyó; en caso contrario devuelve PreconditionFailed.
 func (r *eventRepository) Update(ctx context.Context, id string, event models.Event) (models.Event, error) {
 	objectID, err := primitive.ObjectIDFromHex(id)
 	if err != nil {
@@ -107,54 +717,195 @@ func (r *eventRepository) Update(ctx context.Context, id string, event models.Ev
 
 	event.UpdatedAt = time.Now()
 
+	filter := bson.M{"_id": objectID, "deleted_at": nil, "version": versionFilter(event.Version)}
+
+	result, err := r.collection.UpdateOne(ctx, filter, eventUpdate(event))
+	if err != nil {
+		return models.Event{}, apierror.NewError(apierror.Internal, "error al actualizar el evento: "+err.Error())
+	}
+
+	if result.MatchedCount == 0 {
+		return models.Event{}, r.versionConflict(ctx, objectID)
+	}
+
+	return r.FindByID(ctx, id)
+}
+
+// Patch actualiza únicamente los campos indicados, identificados por su nombre persistido,
+// siempre que el evento conserve la versión indicada
+func (r *eventRepository) Patch(ctx context.Context, id string, version int64, fields map[string]interface{}) (models.Event, error) {
+	objectID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return models.Event{}, apierror.NewError(apierror.BadRequest, "ID de evento inválido")
+	}
+
+	set := bson.M{"updated_at": time.Now()}
+	for field, value := range fields {
+		set[field] = value
+	}
+
+	update := bson.M{
+		"$set":   set,
+		"$unset": bson.M{"escalation_due_at": ""},
+		"$inc":   bson.M{"version": 1},
+	}
+
+	filter := bson.M{"_id": objectID, "deleted_at": nil}
+	if version != models.AnyVersion {
+		filter["version"] = versionFilter(version)
+	}
+
+	opts := options.FindOneAndUpdate().SetReturnDocument(options.After)
+
+	var event models.Event
+	err = r.collection.FindOneAndUpdate(ctx, filter, update, opts).Decode(&event)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return models.Event{}, r.versionConflict(ctx, objectID)
+		}
+		return models.Event{}, apierror.NewError(apierror.Internal, "error al actualizar el evento: "+err.Error())
+	}
+
+	return event, nil
+}
+
+// Delete envía un evento a la papelera marcándolo como eliminado y devuelve el evento eliminado.
+// Si version es distinto de models.AnyVersion, el evento debe conservar esa versión.
+func (r *eventRepository) Delete(ctx context.Context, id string, version int64) (models.Event, error) {
+	objectID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return models.Event{}, apierror.NewError(apierror.BadRequest, "ID de evento inválido")
+	}
+
+	now := time.Now()
 	update := bson.M{
 		"$set": bson.M{
-			"name":              event.Name,
-			"type":              event.Type,
-			"description":       event.Description,
-			"date":              event.Date,
-			"status":            event.Status,
-			"management_status": event.ManagementStatus,
-			"updated_at":        event.UpdatedAt,
+			"deleted_at": now,
+			"updated_at": now,
 		},
+		"$inc": bson.M{"version": 1},
+	}
+
+	filter := bson.M{"_id": objectID, "deleted_at": nil}
+	if version != models.AnyVersion {
+		filter["version"] = versionFilter(version)
+	}
+
+	opts := options.FindOneAndUpdate().SetReturnDocument(options.After)
+
+	var event models.Event
+	err = r.collection.FindOneAndUpdate(ctx, filter, update, opts).Decode(&event)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return models.Event{}, r.versionConflict(ctx, objectID)
+		}
+		return models.Event{}, apierror.NewError(apierror.Internal, "error al eliminar el evento: "+err.Error())
 	}
 
-	result, err := r.collection.UpdateOne(ctx, bson.M{"_id": objectID}, update)
+	return event, nil
+}
+
+// FindDeleted recupera una página de los eventos de la papelera, del borrado más reciente al más antiguo
+func (r *eventRepository) FindDeleted(ctx context.Context, opts models.ListOptions) ([]models.Event, int64, error) {
+	findOpts := options.Find().SetSort(bson.D{{Key: "deleted_at", Value: -1}, {Key: "_id", Value: -1}})
+
+	return r.findPage(ctx, bson.M{"deleted_at": bson.M{"$ne": nil}}, findOpts, opts)
+}
+
+// FindDeletedByID recupera un evento de la papelera por su ID
+func (r *eventRepository) FindDeletedByID(ctx context.Context, id string) (models.Event, error) {
+	objectID, err := primitive.ObjectIDFromHex(id)
 	if err != nil {
-		return models.Event{}, apierror.NewError(apierror.Internal, "error al actualizar el evento: "+err.Error())
+		return models.Event{}, apierror.NewError(apierror.BadRequest, "ID de evento inválido")
+	}
+
+	var event models.Event
+	err = r.collection.FindOne(ctx, bson.M{"_id": objectID, "deleted_at": bson.M{"$ne": nil}}).Decode(&event)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return models.Event{}, apierror.NewError(apierror.NotFound, "evento no encontrado en la papelera")
+		}
+		return models.Event{}, apierror.NewError(apierror.Internal, "error al buscar el evento: "+err.Error())
+	}
+
+	return event, nil
+}
+
+// Restore saca un evento de la papelera
+func (r *eventRepository) Restore(ctx context.Context, id string) (models.Event, error) {
+	objectID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return models.Event{}, apierror.NewError(apierror.BadRequest, "ID de evento inválido")
+	}
+
+	update := bson.M{
+		"$unset": bson.M{"deleted_at": "", "escalation_due_at": ""},
+		"$set":   bson.M{"updated_at": time.Now()},
+		"$inc":   bson.M{"version": 1},
+	}
+
+	result, err := r.collection.UpdateOne(ctx, bson.M{"_id": objectID, "deleted_at": bson.M{"$ne": nil}}, update)
+	if err != nil {
+		return models.Event{}, apierror.NewError(apierror.Internal, "error al restaurar el evento: "+err.Error())
 	}
 
 	if result.MatchedCount == 0 {
-		return models.Event{}, apierror.NewError(apierror.NotFound, "evento no encontrado")
+		return models.Event{}, apierror.NewError(apierror.NotFound, "evento no encontrado en la papelera")
 	}
 
 	return r.FindByID(ctx, id)
 }
 
-// Delete elimina un evento
-func (r *eventRepository) Delete(ctx context.Context, id string) error {
-	objectID, err := primitive.ObjectIDFromHex(id)
+// PurgeDeleted elimina definitivamente los eventos indicados que siguen en la papelera desde antes
+// de la fecha indicada y devuelve los identificadores de los eliminados. Los eventos restaurados
+// o enviados de nuevo a la papelera después de leerlos se conservan.
+func (r *eventRepository) PurgeDeleted(ctx context.Context, ids []primitive.ObjectID, deletedBefore time.Time) ([]primitive.ObjectID, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+
+	filter := bson.M{"_id": bson.M{"$in": ids}, "deleted_at": bson.M{"$lte": deletedBefore}}
+	result, err := r.collection.DeleteMany(ctx, filter)
 	if err != nil {
-		return apierror.NewError(apierror.BadRequest, "ID de evento inválido")
+		return nil, err
+	}
+
+	if result.DeletedCount == int64(len(ids)) {
+		return ids, nil
 	}
 
-	result, err := r.collection.DeleteOne(ctx, bson.M{"_id": objectID})
+	// Los eventos que siguen existiendo no cumplían la condición al eliminarlos
+	cursor, err := r.collection.Find(ctx, bson.M{"_id": bson.M{"$in": ids}}, options.Find().SetProjection(bson.M{"_id": 1}))
 	if err != nil {
-		return apierror.NewError(apierror.Internal, "error al eliminar el evento: "+err.Error())
+		return nil, err
 	}
+	defer cursor.Close(ctx)
 
-	if result.DeletedCount == 0 {
-		return apierror.NewError(apierror.NotFound, "evento no encontrado")
+	var remaining []models.Event
+	if err := cursor.All(ctx, &remaining); err != nil {
+		return nil, err
 	}
 
-	return nil
+	kept := make(map[primitive.ObjectID]bool, len(remaining))
+	for _, event := range remaining {
+		kept[event.ID] = true
+	}
+
+	purged := make([]primitive.ObjectID, 0, len(ids)-len(kept))
+	for _, id := range ids {
+		if !kept[id] {
+			purged = append(purged, id)
+		}
+	}
+
+	return purged, nil
 }
 
 // FindByStatus recupera eventos por su estado
 func (r *eventRepository) FindByStatus(ctx context.Context, status models.EventStatus) ([]models.Event, error) {
 	opts := options.Find().SetSort(bson.D{{Key: "created_at", Value: -1}})
 
-	cursor, err := r.collection.Find(ctx, bson.M{"status": status}, opts)
+	cursor, err := r.collection.Find(ctx, bson.M{"status": status, "deleted_at": nil}, opts)
 	if err != nil {
 		return nil, err
 	}
@@ -168,13 +919,15 @@ func (r *eventRepository) FindByStatus(ctx context.Context, status models.EventS
 	return events, nil
 }
 
-// FindByManagementStatus recupera eventos por su estado de gestión
+// FindByManagementStatus recupera los eventos revisados, en cualquier estado posterior a la
+// revisión, por su estado de gestión
 func (r *eventRepository) FindByManagementStatus(ctx context.Context, managementStatus models.ManagementStatus) ([]models.Event, error) {
 	opts := options.Find().SetSort(bson.D{{Key: "created_at", Value: -1}})
 
 	filter := bson.M{
-		"status":            models.StatusReviewed,
+		"status":            bson.M{"$ne": models.StatusPending},
 		"management_status": managementStatus,
+		"deleted_at":        nil,
 	}
 
 	cursor, err := r.collection.Find(ctx, filter, opts)
@@ -191,19 +944,26 @@ func (r *eventRepository) FindByManagementStatus(ctx context.Context, management
 	return events, nil
 }
 
-// BulkInsert inserta múltiples eventos
-func (r *eventRepository) BulkInsert(ctx context.Context, events []models.Event) error {
+// BulkInsert inserta múltiples eventos con una inserción no ordenada, de modo que un fallo no
+// impide insertar el resto. Los eventos sin estado se crean como pendientes. Devuelve, por
+// posición, el error de los eventos que no se pudieron insertar.
+func (r *eventRepository) BulkInsert(ctx context.Context, events []models.Event) ([]error, error) {
+	errs := make([]error, len(events))
 	if len(events) == 0 {
-		return nil
+		return errs, nil
 	}
 
 	now := time.Now()
-	var documents []interface{}
+	documents := make([]interface{}, 0, len(events))
 
 	for i := range events {
 		events[i].CreatedAt = now
 		events[i].UpdatedAt = now
-		events[i].Status = models.StatusPending
+		events[i].Version = 1
+
+		if events[i].Status == "" {
+			events[i].Status = models.StatusPending
+		}
 
 		if events[i].ID.IsZero() {
 			events[i].ID = primitive.NewObjectID()
@@ -212,6 +972,448 @@ func (r *eventRepository) BulkInsert(ctx context.Context, events []models.Event)
 		documents = append(documents, events[i])
 	}
 
-	_, err := r.collection.InsertMany(ctx, documents)
-	return err
+	_, err := r.collection.InsertMany(ctx, documents, options.InsertMany().SetOrdered(false))
+	if err != nil {
+		var bulkErr mongo.BulkWriteException
+		if !errors.As(err, &bulkErr) || bulkErr.WriteConcernError != nil {
+			return nil, apierror.NewError(apierror.Internal, "error al insertar los eventos: "+err.Error())
+		}
+
+		for _, writeErr := range bulkErr.WriteErrors {
+			if mongo.IsDuplicateKeyError(writeErr) {
+				errs[writeErr.Index] = apierror.NewError(apierror.ResourceExists, "ya existe un evento con el mismo ID")
+			} else {
+				errs[writeErr.Index] = apierror.NewError(apierror.Internal, "error al insertar el evento: "+writeErr.Message)
+			}
+		}
+	}
+
+	return errs, nil
+}
+
+// errBulkAborted indica que una operación masiva atómica no se aplicó porque alguna de sus
+// actualizaciones no pudo aplicarse
+var errBulkAborted = apierror.NewError(apierror.Aborted, "la operación no se aplicó porque otras operaciones de la solicitud fallaron")
+
+// errTransactionsUnsupported indica que el servidor de MongoDB no admite transacciones
+var errTransactionsUnsupported = apierror.NewError(apierror.Unprocessable, "las operaciones masivas atómicas requieren que MongoDB se ejecute como conjunto de réplicas")
+
+// illegalOperationCode es el código de error de MongoDB al usar transacciones en un servidor independiente
+const illegalOperationCode = 20
+
+// bulkWriteOutcome es el resultado de una escritura de una operación BulkWrite
+type bulkWriteOutcome int
+
+const (
+	// bulkWriteApplied indica que la escritura actualizó el evento
+	bulkWriteApplied bulkWriteOutcome = iota
+	// bulkWriteConflict indica que el evento cambió de versión
+	bulkWriteConflict
+	// bulkWriteDeleted indica que el evento está en la papelera
+	bulkWriteDeleted
+	// bulkWriteMissing indica que el evento no existe
+	bulkWriteMissing
+	// bulkWriteFailed indica que la escritura falló por otro motivo
+	bulkWriteFailed
+)
+
+// classifyBulkWrites determina el resultado de cada escritura de una operación BulkWrite a partir
+// de los errores devueltos y del estado de los eventos releído tras la operación. Una escritura se
+// aplicó si el evento tiene la versión siguiente a la indicada y la fecha de actualización de la
+// operación; si no, el evento no existe, está en la papelera o cambió de versión.
+func classifyBulkWrites(events []models.Event, updatedAt time.Time, stored map[primitive.ObjectID]models.Event, writeErrors []mongo.BulkWriteError) []bulkWriteOutcome {
+	outcomes := make([]bulkWriteOutcome, len(events))
+
+	failed := make(map[int]bool, len(writeErrors))
+	for _, writeErr := range writeErrors {
+		failed[writeErr.Index] = true
+	}
+
+	for i, event := range events {
+		current, ok := stored[event.ID]
+		switch {
+		case failed[i]:
+			outcomes[i] = bulkWriteFailed
+		case !ok:
+			outcomes[i] = bulkWriteMissing
+		case current.Version == event.Version+1 && current.UpdatedAt.Equal(updatedAt):
+			outcomes[i] = bulkWriteApplied
+		case current.DeletedAt != nil:
+			outcomes[i] = bulkWriteDeleted
+		default:
+			outcomes[i] = bulkWriteConflict
+		}
+	}
+
+	return outcomes
+}
+
+// bulkWriteErrors convierte los resultados de las escrituras de una operación BulkWrite en el
+// error de cada una, nil si se aplicó
+func bulkWriteErrors(outcomes []bulkWriteOutcome, writeErrors []mongo.BulkWriteError) []error {
+	writeErrorMessages := make(map[int]string, len(writeErrors))
+	for _, writeErr := range writeErrors {
+		writeErrorMessages[writeErr.Index] = writeErr.Message
+	}
+
+	errs := make([]error, len(outcomes))
+	for i, outcome := range outcomes {
+		switch outcome {
+		case bulkWriteConflict:
+			errs[i] = apierror.NewError(apierror.PreconditionFailed, "el evento fue modificado por otra solicitud")
+		case bulkWriteDeleted:
+			errs[i] = apierror.NewError(apierror.NotFound, "el evento está en la papelera")
+		case bulkWriteMissing:
+			errs[i] = apierror.NewError(apierror.NotFound, "evento no encontrado")
+		case bulkWriteFailed:
+			errs[i] = apierror.NewError(apierror.Internal, "error al actualizar el evento: "+writeErrorMessages[i])
+		}
+	}
+
+	return errs
+}
+
+// BulkUpdate guarda las nuevas versiones de varios eventos con una sola operación BulkWrite.
+// Cada evento se actualiza solo si no está en la papelera y conserva la versión indicada en él.
+// Devuelve los eventos actualizados y, por posición, el error de los que no se pudieron
+// actualizar. Si atomic es true las actualizaciones se aplican en una transacción y, si alguna
+// no se aplica, no se aplica ninguna: se devuelve errBulkAborted junto al error de cada una de
+// las que fallaron.
+//
+// MongoDB solo informa del total de eventos actualizados; si no se actualizaron todos, los
+// eventos se releen para determinar cuáles no existen, están en la papelera o cambiaron de versión.
+func (r *eventRepository) BulkUpdate(ctx context.Context, events []models.Event, atomic bool) ([]models.Event, []error, error) {
+	if len(events) == 0 {
+		return nil, nil, nil
+	}
+
+	// MongoDB guarda las fechas con precisión de milisegundos
+	now := time.Now().Truncate(time.Millisecond)
+
+	ids := make([]primitive.ObjectID, len(events))
+	writes := make([]mongo.WriteModel, len(events))
+	for i, event := range events {
+		event.UpdatedAt = now
+		ids[i] = event.ID
+		writes[i] = mongo.NewUpdateOneModel().
+			SetFilter(bson.M{"_id": event.ID, "deleted_at": nil, "version": versionFilter(event.Version)}).
+			SetUpdate(eventUpdate(event))
+	}
+
+	var stored map[primitive.ObjectID]models.Event
+	var outcomes []bulkWriteOutcome
+	var writeErrors []mongo.BulkWriteError
+
+	if atomic {
+		session, err := r.collection.Database().Client().StartSession()
+		if err != nil {
+			return nil, nil, apierror.NewError(apierror.Internal, "error al iniciar la transacción: "+err.Error())
+		}
+		defer session.EndSession(ctx)
+
+		var errs []error
+		_, err = session.WithTransaction(ctx, func(sessionCtx mongo.SessionContext) (interface{}, error) {
+			errs = nil
+
+			result, err := r.collection.BulkWrite(sessionCtx, writes)
+			if err != nil {
+				return nil, err
+			}
+
+			// Dentro de la transacción se leen las escrituras propias, por lo que los eventos
+			// releídos reflejan qué actualizaciones se aplicaron
+			stored, err = r.findEventsByID(sessionCtx, ids)
+			if err != nil {
+				return nil, err
+			}
+
+			if result.MatchedCount < int64(len(writes)) {
+				// Al devolver un error la transacción se aborta y no se aplica ninguna actualización
+				errs = bulkWriteErrors(classifyBulkWrites(events, now, stored, nil), nil)
+				return nil, errBulkAborted
+			}
+			return nil, nil
+		})
+		if err != nil {
+			if _, ok := apierror.AsError(err); ok {
+				return nil, errs, err
+			}
+			var serverErr mongo.ServerError
+			if errors.As(err, &serverErr) && serverErr.HasErrorCode(illegalOperationCode) {
+				return nil, nil, errTransactionsUnsupported
+			}
+			return nil, nil, apierror.NewError(apierror.Internal, "error en la transacción de la operación masiva: "+err.Error())
+		}
+
+		outcomes = make([]bulkWriteOutcome, len(writes))
+	} else {
+		result, err := r.collection.BulkWrite(ctx, writes, options.BulkWrite().SetOrdered(false))
+		var bulkErr mongo.BulkWriteException
+		if err != nil && !errors.As(err, &bulkErr) {
+			return nil, nil, apierror.NewError(apierror.Internal, "error en la operación masiva: "+err.Error())
+		}
+		writeErrors = bulkErr.WriteErrors
+
+		stored, err = r.findEventsByID(ctx, ids)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		if result != nil && result.MatchedCount == int64(len(writes)) && len(writeErrors) == 0 {
+			outcomes = make([]bulkWriteOutcome, len(writes))
+		} else {
+			outcomes = classifyBulkWrites(events, now, stored, writeErrors)
+		}
+	}
+
+	errs := bulkWriteErrors(outcomes, writeErrors)
+	updated := make([]models.Event, len(events))
+	for i, event := range events {
+		if errs[i] != nil {
+			continue
+		}
+
+		current, ok := stored[event.ID]
+		if !ok {
+			// El evento se eliminó definitivamente después de actualizarse
+			errs[i] = apierror.NewError(apierror.NotFound, "evento no encontrado")
+			continue
+		}
+		updated[i] = current
+	}
+
+	return updated, errs, nil
+}
+
+// findEventsByID recupera por ID los eventos indicados, incluidos los de la papelera
+func (r *eventRepository) findEventsByID(ctx context.Context, ids []primitive.ObjectID) (map[primitive.ObjectID]models.Event, error) {
+	events, err := r.findEvents(ctx, bson.M{"_id": bson.M{"$in": ids}})
+	if err != nil {
+		return nil, err
+	}
+
+	byID := make(map[primitive.ObjectID]models.Event, len(events))
+	for _, event := range events {
+		byID[event.ID] = event
+	}
+
+	return byID, nil
+}
+
+// versionConflict determina el error de una escritura condicionada que no encontró el evento:
+// NotFound si el evento no existe o está en la papelera, PreconditionFailed si cambió de versión
+func (r *eventRepository) versionConflict(ctx context.Context, objectID primitive.ObjectID) error {
+	count, err := r.collection.CountDocuments(ctx, bson.M{"_id": objectID, "deleted_at": nil})
+	if err != nil {
+		return apierror.NewError(apierror.Internal, "error al buscar el evento: "+err.Error())
+	}
+
+	if count == 0 {
+		return apierror.NewError(apierror.NotFound, "evento no encontrado")
+	}
+
+	return apierror.NewError(apierror.PreconditionFailed, "el evento fue modificado por otra solicitud")
+}
+
+// versionFilter construye la condición de versión de una escritura condicionada.
+// Los eventos creados antes del control de versiones no tienen el campo y equivalen a la versión 0.
+func versionFilter(version int64) interface{} {
+	if version == 0 {
+		return bson.M{"$in": bson.A{0, nil}}
+	}
+	return version
+}
+
+// eventUpdate construye la actualización que guarda los campos modificables de un evento e
+// incrementa su versión
+func eventUpdate(event models.Event) bson.M {
+	set := bson.M{
+		"name":                 event.Name,
+		"type":                 event.Type,
+		"description":          event.Description,
+		"date":                 event.Date,
+		"status":               event.Status,
+		"priority":             event.Priority,
+		"severity":             event.Severity,
+		"tags":                 event.Tags,
+		"labels":               event.Labels,
+		"attributes":           event.Attributes,
+		"management_status":    event.ManagementStatus,
+		"management_rule_id":   event.ManagementRuleID,
+		"management_rule_name": event.ManagementRuleName,
+		"management_source":    event.ManagementSource,
+		"justification":        event.Justification,
+		"assignee_id":          event.AssigneeID,
+		"team_id":              event.TeamID,
+		"status_note":          event.StatusNote,
+		"resolution_note":      event.ResolutionNote,
+		"started_at":           event.StartedAt,
+		"resolved_at":          event.ResolvedAt,
+		"closed_at":            event.ClosedAt,
+		"reopened_at":          event.ReopenedAt,
+		"review_due_at":        event.ReviewDueAt,
+		"resolve_due_at":       event.ResolveDueAt,
+		"updated_at":           event.UpdatedAt,
+	}
+
+	if event.DeletedAt != nil {
+		set["deleted_at"] = *event.DeletedAt
+	}
+
+	// Las marcas de incumplimiento las registra la comprobación periódica de plazos; para no
+	// perder una marca registrada después de leer el evento, solo se eliminan junto a su plazo o
+	// cuando el plazo aún no ha vencido, es decir, cuando se ha calculado de nuevo
+	if event.ReviewBreachedAt != nil {
+		set["review_breached_at"] = *event.ReviewBreachedAt
+	} else if event.ReviewDueAt == nil || event.ReviewDueAt.After(event.UpdatedAt) {
+		set["review_breached_at"] = nil
+	}
+
+	if event.ResolveBreachedAt != nil {
+		set["resolve_breached_at"] = *event.ResolveBreachedAt
+	} else if event.ResolveDueAt == nil || event.ResolveDueAt.After(event.UpdatedAt) {
+		set["resolve_breached_at"] = nil
+	}
+
+	// El escalado se evalúa de nuevo tras cualquier modificación del evento
+	return bson.M{
+		"$set":   set,
+		"$unset": bson.M{"escalation_due_at": ""},
+		"$inc":   bson.M{"version": 1},
+	}
+}
+
+// findEvents recupera todos los eventos que cumplen un filtro, sin paginar
+func (r *eventRepository) findEvents(ctx context.Context, filter bson.M, opts ...*options.FindOptions) ([]models.Event, error) {
+	cursor, err := r.collection.Find(ctx, filter, opts...)
+	if err != nil {
+		return nil, apierror.NewError(apierror.Internal, "error al buscar los eventos: "+err.Error())
+	}
+	defer cursor.Close(ctx)
+
+	var events []models.Event
+	if err := cursor.All(ctx, &events); err != nil {
+		return nil, apierror.NewError(apierror.Internal, "error al decodificar los eventos: "+err.Error())
+	}
+
+	return events, nil
+}
+
+// buildEventFilter convierte un EventFilter en un filtro de MongoDB.
+// Los eventos de la papelera quedan siempre excluidos.
+func buildEventFilter(filter models.EventFilter) bson.M {
+	query := bson.M{"deleted_at": nil}
+
+	if len(filter.Types) > 0 {
+		query["type"] = bson.M{"$in": filter.Types}
+	}
+
+	if len(filter.Statuses) > 0 {
+		query["status"] = bson.M{"$in": filter.Statuses}
+	}
+
+	if len(filter.ManagementStatuses) > 0 {
+		query["management_status"] = bson.M{"$in": filter.ManagementStatuses}
+	}
+
+	if dateRange := buildRangeFilter(filter.DateFrom, filter.DateTo); dateRange != nil {
+		query["date"] = dateRange
+	}
+
+	if createdRange := buildRangeFilter(filter.CreatedFrom, filter.CreatedTo); createdRange != nil {
+		query["created_at"] = createdRange
+	}
+
+	if len(filter.AssigneeIDs) > 0 {
+		query["assignee_id"] = bson.M{"$in": filter.AssigneeIDs}
+	}
+
+	if filter.Unassigned {
+		query["assignee_id"] = bson.M{"$in": bson.A{nil, ""}}
+	}
+
+	if len(filter.TeamIDs) > 0 {
+		query["team_id"] = bson.M{"$in": filter.TeamIDs}
+	}
+
+	if len(filter.Tags) > 0 {
+		query["tags"] = bson.M{"$all": filter.Tags}
+	}
+
+	for key, values := range filter.Labels {
+		query["labels."+key] = bson.M{"$in": values}
+	}
+
+	for path, values := range filter.Attributes {
+		query["attributes."+path] = bson.M{"$in": attributeFilterValues(values)}
+	}
+
+	return query
+}
+
+// attributeFilterValues convierte los valores de un filtro por campo personalizado, que llegan
+// como texto, en los valores que pueden estar guardados: el propio texto y, si lo representan,
+// el número o el booleano correspondiente
+func attributeFilterValues(values []string) bson.A {
+	converted := make(bson.A, 0, len(values)*2)
+	for _, value := range values {
+		converted = append(converted, value)
+
+		if number, err := strconv.ParseFloat(value, 64); err == nil {
+			converted = append(converted, number)
+		}
+
+		if value == "true" || value == "false" {
+			converted = append(converted, value == "true")
+		}
+	}
+	return converted
+}
+
+// buildRangeFilter construye un filtro de rango inclusivo entre dos fechas opcionales
+func buildRangeFilter(from, to *time.Time) bson.M {
+	if from == nil && to == nil {
+		return nil
+	}
+
+	rangeFilter := bson.M{}
+	if from != nil {
+		rangeFilter["$gte"] = *from
+	}
+	if to != nil {
+		rangeFilter["$lte"] = *to
+	}
+
+	return rangeFilter
+}
+
+// buildCursorFilter construye la condición que selecciona los eventos posteriores al cursor
+// en el orden por defecto (fecha de creación e ID descendentes)
+func buildCursorFilter(cursor models.EventCursor) bson.M {
+	return bson.M{
+		"$or": bson.A{
+			bson.M{"created_at": bson.M{"$lt": cursor.CreatedAt}},
+			bson.M{"created_at": cursor.CreatedAt, "_id": bson.M{"$lt": cursor.ID}},
+		},
+	}
+}
+
+// buildSort convierte los criterios de ordenación en un documento de MongoDB.
+// Sin criterios se ordena por fecha de creación descendente, y siempre se añade
+// el _id como desempate para que la paginación sea estable.
+func buildSort(fields []models.SortField) bson.D {
+	if len(fields) == 0 {
+		return bson.D{{Key: "created_at", Value: -1}, {Key: "_id", Value: -1}}
+	}
+
+	sort := make(bson.D, 0, len(fields)+1)
+	for _, field := range fields {
+		direction := 1
+		if field.Descending {
+			direction = -1
+		}
+		sort = append(sort, bson.E{Key: field.Field, Value: direction})
+	}
+
+	return append(sort, bson.E{Key: "_id", Value: -1})
 }
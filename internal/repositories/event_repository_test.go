@@ -0,0 +1,116 @@
+package repositories
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"events-api/internal/apierror"
+	"events-api/internal/models"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// bulkWriteError construye el error de una escritura de una operación BulkWrite
+func bulkWriteError(index int, code int) mongo.BulkWriteError {
+	return mongo.BulkWriteError{WriteError: mongo.WriteError{Index: index, Code: code, Message: "error de prueba"}}
+}
+
+func TestClassifyBulkWrites(t *testing.T) {
+	updatedAt := time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC)
+	earlier := updatedAt.Add(-time.Minute)
+	id := primitive.NewObjectID()
+
+	tests := []struct {
+		name        string
+		event       models.Event
+		stored      *models.Event
+		writeErrors []mongo.BulkWriteError
+		want        bulkWriteOutcome
+	}{
+		{
+			name:   "la escritura se aplicó",
+			event:  models.Event{ID: id, Version: 3},
+			stored: &models.Event{ID: id, Version: 4, UpdatedAt: updatedAt},
+			want:   bulkWriteApplied,
+		},
+		{
+			name:   "un evento sin versión se aplicó",
+			event:  models.Event{ID: id},
+			stored: &models.Event{ID: id, Version: 1, UpdatedAt: updatedAt},
+			want:   bulkWriteApplied,
+		},
+		{
+			name:   "la escritura enviada a la papelera se aplicó",
+			event:  models.Event{ID: id, Version: 3, DeletedAt: &updatedAt},
+			stored: &models.Event{ID: id, Version: 4, UpdatedAt: updatedAt, DeletedAt: &updatedAt},
+			want:   bulkWriteApplied,
+		},
+		{
+			name:   "el evento cambió de versión",
+			event:  models.Event{ID: id, Version: 3},
+			stored: &models.Event{ID: id, Version: 5, UpdatedAt: earlier},
+			want:   bulkWriteConflict,
+		},
+		{
+			name:   "otra solicitud dejó la versión siguiente",
+			event:  models.Event{ID: id, Version: 3},
+			stored: &models.Event{ID: id, Version: 4, UpdatedAt: earlier},
+			want:   bulkWriteConflict,
+		},
+		{
+			name:   "el evento está en la papelera",
+			event:  models.Event{ID: id, Version: 3},
+			stored: &models.Event{ID: id, Version: 3, UpdatedAt: earlier, DeletedAt: &earlier},
+			want:   bulkWriteDeleted,
+		},
+		{
+			name:  "el evento no existe",
+			event: models.Event{ID: id, Version: 3},
+			want:  bulkWriteMissing,
+		},
+		{
+			name:        "la escritura falló",
+			event:       models.Event{ID: id, Version: 3},
+			stored:      &models.Event{ID: id, Version: 3, UpdatedAt: earlier},
+			writeErrors: []mongo.BulkWriteError{bulkWriteError(0, 121)},
+			want:        bulkWriteFailed,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			stored := make(map[primitive.ObjectID]models.Event)
+			if tt.stored != nil {
+				stored[tt.stored.ID] = *tt.stored
+			}
+
+			got := classifyBulkWrites([]models.Event{tt.event}, updatedAt, stored, tt.writeErrors)
+			if !reflect.DeepEqual(got, []bulkWriteOutcome{tt.want}) {
+				t.Fatalf("classifyBulkWrites = %v, se esperaba %v", got, []bulkWriteOutcome{tt.want})
+			}
+		})
+	}
+}
+
+func TestBulkWriteErrors(t *testing.T) {
+	outcomes := []bulkWriteOutcome{bulkWriteApplied, bulkWriteConflict, bulkWriteDeleted, bulkWriteMissing, bulkWriteFailed}
+	want := []apierror.Type{"", apierror.PreconditionFailed, apierror.NotFound, apierror.NotFound, apierror.Internal}
+
+	errs := bulkWriteErrors(outcomes, []mongo.BulkWriteError{bulkWriteError(4, 121)})
+
+	for i, err := range errs {
+		if want[i] == "" {
+			if err != nil {
+				t.Errorf("escritura %d: error %v, se esperaba ninguno", i, err)
+			}
+			continue
+		}
+
+		apiErr, ok := apierror.AsError(err)
+		if !ok || apiErr.Type != want[i] {
+			t.Errorf("escritura %d: error %v, se esperaba %s", i, err, want[i])
+		}
+	}
+}
@@ -0,0 +1,186 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"events-api/internal/apierror"
+	"events-api/internal/config"
+	"events-api/internal/models"
+	"events-api/pkg/database"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// EventTypeRepository define las operaciones del repositorio del registro de tipos de evento
+type EventTypeRepository interface {
+	FindAll(ctx context.Context) ([]models.EventTypeDefinition, error)
+	FindByCode(ctx context.Context, code models.EventType) (models.EventTypeDefinition, error)
+	Create(ctx context.Context, eventType models.EventTypeDefinition) (models.EventTypeDefinition, error)
+	Update(ctx context.Context, eventType models.EventTypeDefinition) (models.EventTypeDefinition, error)
+	Delete(ctx context.Context, code models.EventType) error
+}
+
+// eventTypeRepository implementa EventTypeRepository
+type eventTypeRepository struct {
+	collection *mongo.Collection
+}
+
+// NewEventTypeRepository crea una nueva instancia de EventTypeRepository y registra los tipos
+// de evento predefinidos que no existan
+func NewEventTypeRepository(client *mongo.Client, cfg *config.Config) (EventTypeRepository, error) {
+	collection := database.GetCollection(client, cfg, cfg.EventTypesCollection)
+	repository := &eventTypeRepository{
+		collection: collection,
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	if err := repository.seedBuiltinTypes(ctx); err != nil {
+		return nil, err
+	}
+
+	if err := runMigration(ctx, client, cfg, "event_types_builtin_attributes_schema", repository.migrateBuiltinSchemas); err != nil {
+		return nil, err
+	}
+
+	return repository, nil
+}
+
+// seedBuiltinTypes registra los tipos predefinidos sin modificar los que ya existen, de modo que
+// se conservan los cambios hechos desde la API
+func (r *eventTypeRepository) seedBuiltinTypes(ctx context.Context) error {
+	now := time.Now()
+	writes := make([]mongo.WriteModel, 0, len(models.BuiltinEventTypes))
+	for _, eventType := range models.BuiltinEventTypes {
+		eventType.CreatedAt = now
+		eventType.UpdatedAt = now
+		writes = append(writes, mongo.NewUpdateOneModel().
+			SetFilter(bson.M{"_id": eventType.Code}).
+			SetUpdate(bson.M{"$setOnInsert": eventType}).
+			SetUpsert(true))
+	}
+
+	_, err := r.collection.BulkWrite(ctx, writes, options.BulkWrite().SetOrdered(false))
+	return err
+}
+
+// migrateBuiltinSchemas añade el esquema de campos personalizados a los tipos predefinidos
+// registrados antes de que existieran los esquemas. Se aplica una sola vez, de modo que no
+// vuelve a añadir un esquema eliminado después desde la API.
+func (r *eventTypeRepository) migrateBuiltinSchemas(ctx context.Context) error {
+	for _, eventType := range models.BuiltinEventTypes {
+		if len(eventType.AttributesSchema) == 0 {
+			continue
+		}
+
+		filter := bson.M{"_id": eventType.Code, "attributes_schema": bson.M{"$exists": false}}
+		update := bson.M{"$set": bson.M{"attributes_schema": eventType.AttributesSchema, "updated_at": time.Now()}}
+		if _, err := r.collection.UpdateOne(ctx, filter, update); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// FindAll recupera todos los tipos de evento ordenados por código
+func (r *eventTypeRepository) FindAll(ctx context.Context) ([]models.EventTypeDefinition, error) {
+	cursor, err := r.collection.Find(ctx, bson.M{}, options.Find().SetSort(bson.D{{Key: "_id", Value: 1}}))
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var eventTypes []models.EventTypeDefinition
+	if err := cursor.All(ctx, &eventTypes); err != nil {
+		return nil, err
+	}
+
+	return eventTypes, nil
+}
+
+// FindByCode recupera un tipo de evento por su código
+func (r *eventTypeRepository) FindByCode(ctx context.Context, code models.EventType) (models.EventTypeDefinition, error) {
+	var eventType models.EventTypeDefinition
+	err := r.collection.FindOne(ctx, bson.M{"_id": code}).Decode(&eventType)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return models.EventTypeDefinition{}, apierror.NewError(apierror.NotFound, "tipo de evento no encontrado")
+		}
+		return models.EventTypeDefinition{}, apierror.NewError(apierror.Internal, "error al buscar el tipo de evento: "+err.Error())
+	}
+
+	return eventType, nil
+}
+
+// Create registra un nuevo tipo de evento. Si la definición ya tiene fecha de creación, como al
+// volver a registrar un tipo eliminado, se conserva.
+func (r *eventTypeRepository) Create(ctx context.Context, eventType models.EventTypeDefinition) (models.EventTypeDefinition, error) {
+	now := time.Now()
+
+	if eventType.CreatedAt.IsZero() {
+		eventType.CreatedAt = now
+	}
+	eventType.UpdatedAt = now
+
+	_, err := r.collection.InsertOne(ctx, eventType)
+	if err != nil {
+		if mongo.IsDuplicateKeyError(err) {
+			return models.EventTypeDefinition{}, apierror.NewError(apierror.ResourceExists, "ya existe un tipo de evento con el código "+string(eventType.Code))
+		}
+		return models.EventTypeDefinition{}, apierror.NewError(apierror.Internal, "error al crear el tipo de evento: "+err.Error())
+	}
+
+	return eventType, nil
+}
+
+// Update actualiza un tipo de evento existente
+func (r *eventTypeRepository) Update(ctx context.Context, eventType models.EventTypeDefinition) (models.EventTypeDefinition, error) {
+	set := bson.M{
+		"name":                      eventType.Name,
+		"description":               eventType.Description,
+		"color":                     eventType.Color,
+		"default_management_status": eventType.DefaultManagementStatus,
+		"default_priority":          eventType.DefaultPriority,
+		"default_severity":          eventType.DefaultSeverity,
+		"active":                    eventType.Active,
+		"updated_at":                time.Now(),
+	}
+
+	update := bson.M{"$set": set}
+	if len(eventType.AttributesSchema) > 0 {
+		set["attributes_schema"] = eventType.AttributesSchema
+	} else {
+		update["$unset"] = bson.M{"attributes_schema": ""}
+	}
+
+	result, err := r.collection.UpdateOne(ctx, bson.M{"_id": eventType.Code}, update)
+	if err != nil {
+		return models.EventTypeDefinition{}, apierror.NewError(apierror.Internal, "error al actualizar el tipo de evento: "+err.Error())
+	}
+
+	if result.MatchedCount == 0 {
+		return models.EventTypeDefinition{}, apierror.NewError(apierror.NotFound, "tipo de evento no encontrado")
+	}
+
+	return r.FindByCode(ctx, eventType.Code)
+}
+
+// Delete elimina un tipo de evento
+func (r *eventTypeRepository) Delete(ctx context.Context, code models.EventType) error {
+	result, err := r.collection.DeleteOne(ctx, bson.M{"_id": code})
+	if err != nil {
+		return apierror.NewError(apierror.Internal, "error al eliminar el tipo de evento: "+err.Error())
+	}
+
+	if result.DeletedCount == 0 {
+		return apierror.NewError(apierror.NotFound, "tipo de evento no encontrado")
+	}
+
+	return nil
+}
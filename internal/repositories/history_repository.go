@@ -0,0 +1,143 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"time"
+
+	"events-api/internal/apierror"
+	"events-api/internal/config"
+	"events-api/internal/models"
+	"events-api/pkg/database"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/bsontype"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// HistoryRepository define las operaciones del repositorio del historial de eventos.
+// El historial es de solo inserción: no expone operaciones de actualización ni borrado.
+type HistoryRepository interface {
+	Create(ctx context.Context, entry models.EventHistoryEntry) error
+	CreateMany(ctx context.Context, entries []models.EventHistoryEntry) error
+	FindByEventID(ctx context.Context, eventID string, opts models.ListOptions) ([]models.EventHistoryEntry, int64, error)
+}
+
+// historyRepository implementa HistoryRepository
+type historyRepository struct {
+	collection *mongo.Collection
+}
+
+// NewHistoryRepository crea una nueva instancia de HistoryRepository y asegura sus índices
+func NewHistoryRepository(client *mongo.Client, cfg *config.Config) (HistoryRepository, error) {
+	// Los valores anteriores y posteriores de los cambios pueden ser documentos; se decodifican
+	// como bson.M para que se serialicen a JSON como objetos
+	registry := bson.NewRegistry()
+	registry.RegisterTypeMapEntry(bsontype.EmbeddedDocument, reflect.TypeOf(bson.M{}))
+
+	collection := database.GetCollection(client, cfg, cfg.HistoryCollection, options.Collection().SetRegistry(registry))
+	repository := &historyRepository{
+		collection: collection,
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	_, err := collection.Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys:    bson.D{{Key: "event_id", Value: 1}, {Key: "timestamp", Value: -1}, {Key: "_id", Value: -1}},
+		Options: options.Index().SetName("history_event_timestamp"),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return repository, nil
+}
+
+// Create inserta una entrada en el historial
+func (r *historyRepository) Create(ctx context.Context, entry models.EventHistoryEntry) error {
+	if entry.ID.IsZero() {
+		entry.ID = primitive.NewObjectID()
+	}
+
+	_, err := r.collection.InsertOne(ctx, entry)
+	if mongo.IsDuplicateKeyError(err) {
+		// La entrada ya se insertó en un intento anterior
+		return nil
+	}
+	return err
+}
+
+// CreateMany inserta varias entradas en el historial. Se puede reintentar con las mismas
+// entradas: las que ya se insertaron en un intento anterior se ignoran.
+func (r *historyRepository) CreateMany(ctx context.Context, entries []models.EventHistoryEntry) error {
+	if len(entries) == 0 {
+		return nil
+	}
+
+	documents := make([]interface{}, 0, len(entries))
+	for i := range entries {
+		if entries[i].ID.IsZero() {
+			entries[i].ID = primitive.NewObjectID()
+		}
+		documents = append(documents, entries[i])
+	}
+
+	_, err := r.collection.InsertMany(ctx, documents, options.InsertMany().SetOrdered(false))
+	if onlyDuplicateKeyErrors(err) {
+		return nil
+	}
+	return err
+}
+
+// onlyDuplicateKeyErrors indica si todas las escrituras que fallaron en una inserción múltiple lo
+// hicieron por clave duplicada
+func onlyDuplicateKeyErrors(err error) bool {
+	var bulkErr mongo.BulkWriteException
+	if !errors.As(err, &bulkErr) || bulkErr.WriteConcernError != nil || len(bulkErr.WriteErrors) == 0 {
+		return false
+	}
+
+	for _, writeErr := range bulkErr.WriteErrors {
+		if !mongo.IsDuplicateKeyError(writeErr) {
+			return false
+		}
+	}
+	return true
+}
+
+// FindByEventID recupera una página del historial de un evento, de la entrada más reciente a la más antigua
+func (r *historyRepository) FindByEventID(ctx context.Context, eventID string, opts models.ListOptions) ([]models.EventHistoryEntry, int64, error) {
+	objectID, err := primitive.ObjectIDFromHex(eventID)
+	if err != nil {
+		return nil, 0, apierror.NewError(apierror.BadRequest, "ID de evento inválido")
+	}
+
+	filter := bson.M{"event_id": objectID}
+
+	total, err := r.collection.CountDocuments(ctx, filter)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	findOpts := options.Find().
+		SetSort(bson.D{{Key: "timestamp", Value: -1}, {Key: "_id", Value: -1}}).
+		SetSkip(opts.Skip()).
+		SetLimit(opts.PageSize)
+
+	cursor, err := r.collection.Find(ctx, filter, findOpts)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer cursor.Close(ctx)
+
+	var entries []models.EventHistoryEntry
+	if err := cursor.All(ctx, &entries); err != nil {
+		return nil, 0, err
+	}
+
+	return entries, total, nil
+}
@@ -0,0 +1,131 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"events-api/internal/apierror"
+	"events-api/internal/config"
+	"events-api/internal/models"
+	"events-api/pkg/database"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// IdempotencyRepository define las operaciones del repositorio de claves de idempotencia
+type IdempotencyRepository interface {
+	Reserve(ctx context.Context, record models.IdempotencyRecord) (models.IdempotencyRecord, bool, error)
+	Complete(ctx context.Context, id string, statusCode int, headers map[string]string, body []byte) error
+	Release(ctx context.Context, id string) error
+}
+
+// idempotencyRepository implementa IdempotencyRepository
+type idempotencyRepository struct {
+	collection *mongo.Collection
+}
+
+const (
+	// idempotencyTTLIndex es el nombre del índice TTL de las claves de idempotencia
+	idempotencyTTLIndex = "idempotency_created_at_ttl"
+	// indexOptionsConflictCode es el código de error de MongoDB al crear un índice que ya existe
+	// con otras opciones
+	indexOptionsConflictCode = 85
+)
+
+// NewIdempotencyRepository crea una nueva instancia de IdempotencyRepository y asegura el índice
+// TTL que elimina las claves cuando superan el tiempo de retención configurado
+func NewIdempotencyRepository(client *mongo.Client, cfg *config.Config) (IdempotencyRepository, error) {
+	collection := database.GetCollection(client, cfg, cfg.IdempotencyCollection)
+	repository := &idempotencyRepository{
+		collection: collection,
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	expireAfterSeconds := int32(cfg.IdempotencyTTL / time.Second)
+
+	_, err := collection.Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys: bson.D{{Key: "created_at", Value: 1}},
+		Options: options.Index().
+			SetName(idempotencyTTLIndex).
+			SetExpireAfterSeconds(expireAfterSeconds),
+	})
+
+	// Si el índice ya existe con otra retención, se actualiza sin recrearlo
+	var commandErr mongo.CommandError
+	if errors.As(err, &commandErr) && commandErr.HasErrorCode(indexOptionsConflictCode) {
+		err = collection.Database().RunCommand(ctx, bson.D{
+			{Key: "collMod", Value: collection.Name()},
+			{Key: "index", Value: bson.D{
+				{Key: "name", Value: idempotencyTTLIndex},
+				{Key: "expireAfterSeconds", Value: expireAfterSeconds},
+			}},
+		}).Err()
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return repository, nil
+}
+
+// Reserve registra una clave de idempotencia pendiente. Si la clave ya existe no se modifica y
+// se devuelve el registro almacenado junto a false.
+func (r *idempotencyRepository) Reserve(ctx context.Context, record models.IdempotencyRecord) (models.IdempotencyRecord, bool, error) {
+	record.Completed = false
+	record.CreatedAt = time.Now()
+
+	_, err := r.collection.InsertOne(ctx, record)
+	if err == nil {
+		return record, true, nil
+	}
+
+	if !mongo.IsDuplicateKeyError(err) {
+		return models.IdempotencyRecord{}, false, apierror.NewError(apierror.Internal, "error al registrar la clave de idempotencia: "+err.Error())
+	}
+
+	var existing models.IdempotencyRecord
+	err = r.collection.FindOne(ctx, bson.M{"_id": record.ID}).Decode(&existing)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			// La clave expiró entre la inserción y la lectura; se reintenta la reserva
+			return r.Reserve(ctx, record)
+		}
+		return models.IdempotencyRecord{}, false, apierror.NewError(apierror.Internal, "error al buscar la clave de idempotencia: "+err.Error())
+	}
+
+	return existing, false, nil
+}
+
+// Complete almacena la respuesta de una clave de idempotencia reservada
+func (r *idempotencyRepository) Complete(ctx context.Context, id string, statusCode int, headers map[string]string, body []byte) error {
+	update := bson.M{
+		"$set": bson.M{
+			"completed":   true,
+			"status_code": statusCode,
+			"headers":     headers,
+			"body":        body,
+		},
+	}
+
+	_, err := r.collection.UpdateOne(ctx, bson.M{"_id": id}, update)
+	if err != nil {
+		return apierror.NewError(apierror.Internal, "error al almacenar la respuesta idempotente: "+err.Error())
+	}
+
+	return nil
+}
+
+// Release elimina una clave de idempotencia reservada para que la solicitud pueda reintentarse
+func (r *idempotencyRepository) Release(ctx context.Context, id string) error {
+	_, err := r.collection.DeleteOne(ctx, bson.M{"_id": id, "completed": false})
+	if err != nil {
+		return apierror.NewError(apierror.Internal, "error al liberar la clave de idempotencia: "+err.Error())
+	}
+
+	return nil
+}
@@ -0,0 +1,40 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"events-api/internal/config"
+	"events-api/pkg/database"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// migrationRecord registra una migración de datos ya aplicada
+type migrationRecord struct {
+	Name      string    `bson:"_id"`
+	AppliedAt time.Time `bson:"applied_at"`
+}
+
+// runMigration aplica una migración de datos si no se ha aplicado antes. Las migraciones
+// aplicadas se registran por nombre en la colección de migraciones. Dos instancias que arrancan
+// a la vez pueden aplicar la misma migración, por lo que deben ser idempotentes.
+func runMigration(ctx context.Context, client *mongo.Client, cfg *config.Config, name string, migrate func(ctx context.Context) error) error {
+	collection := database.GetCollection(client, cfg, cfg.MigrationsCollection)
+
+	count, err := collection.CountDocuments(ctx, bson.M{"_id": name})
+	if err != nil || count > 0 {
+		return err
+	}
+
+	if err := migrate(ctx); err != nil {
+		return err
+	}
+
+	_, err = collection.InsertOne(ctx, migrationRecord{Name: name, AppliedAt: time.Now()})
+	if mongo.IsDuplicateKeyError(err) {
+		return nil
+	}
+	return err
+}
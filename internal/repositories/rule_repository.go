@@ -0,0 +1,209 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"events-api/internal/apierror"
+	"events-api/internal/config"
+	"events-api/internal/models"
+	"events-api/pkg/database"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// RuleRepository define las operaciones del repositorio de reglas de gestión
+type RuleRepository interface {
+	FindAll(ctx context.Context) ([]models.ManagementRule, error)
+	FindEnabled(ctx context.Context) ([]models.ManagementRule, error)
+	FindByID(ctx context.Context, id string) (models.ManagementRule, error)
+	FindDefault(ctx context.Context) (models.ManagementRule, error)
+	Create(ctx context.Context, rule models.ManagementRule) (models.ManagementRule, error)
+	Update(ctx context.Context, id string, rule models.ManagementRule) (models.ManagementRule, error)
+	Delete(ctx context.Context, id string) error
+	ExistsByType(ctx context.Context, eventType models.EventType) (bool, error)
+}
+
+// ruleRepository implementa RuleRepository
+type ruleRepository struct {
+	collection *mongo.Collection
+}
+
+// NewRuleRepository crea una nueva instancia de RuleRepository y asegura sus índices
+func NewRuleRepository(client *mongo.Client, cfg *config.Config) (RuleRepository, error) {
+	collection := database.GetCollection(client, cfg, cfg.RulesCollection)
+	repository := &ruleRepository{
+		collection: collection,
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	// Solo puede haber una regla predeterminada
+	_, err := collection.Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys: bson.D{{Key: "is_default", Value: 1}},
+		Options: options.Index().
+			SetName("rules_default").
+			SetUnique(true).
+			SetPartialFilterExpression(bson.M{"is_default": true}),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return repository, nil
+}
+
+// FindAll recupera todas las reglas en orden de evaluación
+func (r *ruleRepository) FindAll(ctx context.Context) ([]models.ManagementRule, error) {
+	return r.find(ctx, bson.M{})
+}
+
+// FindEnabled recupera las reglas habilitadas en orden de evaluación
+func (r *ruleRepository) FindEnabled(ctx context.Context) ([]models.ManagementRule, error) {
+	return r.find(ctx, bson.M{"enabled": true})
+}
+
+// FindByID recupera una regla por su ID
+func (r *ruleRepository) FindByID(ctx context.Context, id string) (models.ManagementRule, error) {
+	objectID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return models.ManagementRule{}, apierror.NewError(apierror.BadRequest, "ID de regla inválido")
+	}
+
+	return r.findOne(ctx, bson.M{"_id": objectID})
+}
+
+// FindDefault recupera la regla marcada como predeterminada
+func (r *ruleRepository) FindDefault(ctx context.Context) (models.ManagementRule, error) {
+	return r.findOne(ctx, bson.M{"is_default": true})
+}
+
+// Create crea una nueva regla
+func (r *ruleRepository) Create(ctx context.Context, rule models.ManagementRule) (models.ManagementRule, error) {
+	now := time.Now()
+
+	rule.CreatedAt = now
+	rule.UpdatedAt = now
+
+	if rule.ID.IsZero() {
+		rule.ID = primitive.NewObjectID()
+	}
+
+	_, err := r.collection.InsertOne(ctx, rule)
+	if err != nil {
+		if mongo.IsDuplicateKeyError(err) {
+			return models.ManagementRule{}, duplicateDefaultRule()
+		}
+		return models.ManagementRule{}, apierror.NewError(apierror.Internal, "error al crear la regla: "+err.Error())
+	}
+
+	return rule, nil
+}
+
+// Update actualiza una regla existente
+func (r *ruleRepository) Update(ctx context.Context, id string, rule models.ManagementRule) (models.ManagementRule, error) {
+	objectID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return models.ManagementRule{}, apierror.NewError(apierror.BadRequest, "ID de regla inválido")
+	}
+
+	rule.UpdatedAt = time.Now()
+
+	update := bson.M{
+		"$set": bson.M{
+			"name":        rule.Name,
+			"description": rule.Description,
+			"priority":    rule.Priority,
+			"enabled":     rule.Enabled,
+			"is_default":  rule.IsDefault,
+			"conditions":  rule.Conditions,
+			"result":      rule.Result,
+			"updated_at":  rule.UpdatedAt,
+		},
+	}
+
+	result, err := r.collection.UpdateOne(ctx, bson.M{"_id": objectID}, update)
+	if err != nil {
+		if mongo.IsDuplicateKeyError(err) {
+			return models.ManagementRule{}, duplicateDefaultRule()
+		}
+		return models.ManagementRule{}, apierror.NewError(apierror.Internal, "error al actualizar la regla: "+err.Error())
+	}
+
+	if result.MatchedCount == 0 {
+		return models.ManagementRule{}, apierror.NewError(apierror.NotFound, "regla no encontrada")
+	}
+
+	return r.FindByID(ctx, id)
+}
+
+// ExistsByType indica si alguna regla tiene el tipo de evento indicado entre sus condiciones
+func (r *ruleRepository) ExistsByType(ctx context.Context, eventType models.EventType) (bool, error) {
+	count, err := r.collection.CountDocuments(ctx, bson.M{"conditions.types": eventType}, options.Count().SetLimit(1))
+	if err != nil {
+		return false, apierror.NewError(apierror.Internal, "error al buscar las reglas del tipo: "+err.Error())
+	}
+
+	return count > 0, nil
+}
+
+// Delete elimina una regla
+func (r *ruleRepository) Delete(ctx context.Context, id string) error {
+	objectID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return apierror.NewError(apierror.BadRequest, "ID de regla inválido")
+	}
+
+	result, err := r.collection.DeleteOne(ctx, bson.M{"_id": objectID})
+	if err != nil {
+		return apierror.NewError(apierror.Internal, "error al eliminar la regla: "+err.Error())
+	}
+
+	if result.DeletedCount == 0 {
+		return apierror.NewError(apierror.NotFound, "regla no encontrada")
+	}
+
+	return nil
+}
+
+// find recupera las reglas que cumplen el filtro ordenadas por prioridad y antigüedad
+func (r *ruleRepository) find(ctx context.Context, filter bson.M) ([]models.ManagementRule, error) {
+	opts := options.Find().SetSort(bson.D{{Key: "priority", Value: 1}, {Key: "created_at", Value: 1}})
+
+	cursor, err := r.collection.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var rules []models.ManagementRule
+	if err := cursor.All(ctx, &rules); err != nil {
+		return nil, err
+	}
+
+	return rules, nil
+}
+
+// findOne recupera la primera regla que cumple el filtro
+func (r *ruleRepository) findOne(ctx context.Context, filter bson.M) (models.ManagementRule, error) {
+	var rule models.ManagementRule
+	err := r.collection.FindOne(ctx, filter).Decode(&rule)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return models.ManagementRule{}, apierror.NewError(apierror.NotFound, "regla no encontrada")
+		}
+		return models.ManagementRule{}, apierror.NewError(apierror.Internal, "error al buscar la regla: "+err.Error())
+	}
+
+	return rule, nil
+}
+
+// duplicateDefaultRule construye el error de una regla predeterminada cuando ya existe otra
+func duplicateDefaultRule() error {
+	return apierror.NewError(apierror.ResourceExists, "ya existe una regla predeterminada")
+}
@@ -0,0 +1,107 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"events-api/internal/apierror"
+	"events-api/internal/config"
+	"events-api/internal/models"
+	"events-api/pkg/database"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// SLAPolicyRepository define las operaciones del repositorio de políticas de SLA. Las
+// políticas se identifican por el tipo de evento al que se aplican.
+type SLAPolicyRepository interface {
+	FindAll(ctx context.Context) ([]models.SLAPolicy, error)
+	FindByType(ctx context.Context, eventType models.EventType) (models.SLAPolicy, error)
+	Upsert(ctx context.Context, policy models.SLAPolicy) (models.SLAPolicy, error)
+	Delete(ctx context.Context, eventType models.EventType) error
+	ExistsByType(ctx context.Context, eventType models.EventType) (bool, error)
+}
+
+// slaPolicyRepository implementa SLAPolicyRepository
+type slaPolicyRepository struct {
+	collection *mongo.Collection
+}
+
+// NewSLAPolicyRepository crea una nueva instancia de SLAPolicyRepository
+func NewSLAPolicyRepository(client *mongo.Client, cfg *config.Config) SLAPolicyRepository {
+	collection := database.GetCollection(client, cfg, cfg.SLAPoliciesCollection)
+	return &slaPolicyRepository{
+		collection: collection,
+	}
+}
+
+// FindAll recupera las políticas configuradas
+func (r *slaPolicyRepository) FindAll(ctx context.Context) ([]models.SLAPolicy, error) {
+	cursor, err := r.collection.Find(ctx, bson.M{}, options.Find().SetSort(bson.M{"_id": 1}))
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var policies []models.SLAPolicy
+	if err := cursor.All(ctx, &policies); err != nil {
+		return nil, err
+	}
+
+	return policies, nil
+}
+
+// FindByType recupera la política configurada para un tipo de evento
+func (r *slaPolicyRepository) FindByType(ctx context.Context, eventType models.EventType) (models.SLAPolicy, error) {
+	var policy models.SLAPolicy
+	err := r.collection.FindOne(ctx, bson.M{"_id": eventType}).Decode(&policy)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return models.SLAPolicy{}, apierror.NewError(apierror.NotFound, "política de SLA no encontrada")
+		}
+		return models.SLAPolicy{}, apierror.NewError(apierror.Internal, "error al buscar la política de SLA: "+err.Error())
+	}
+
+	return policy, nil
+}
+
+// Upsert crea o sustituye la política de un tipo de evento
+func (r *slaPolicyRepository) Upsert(ctx context.Context, policy models.SLAPolicy) (models.SLAPolicy, error) {
+	now := time.Now()
+	policy.UpdatedAt = &now
+
+	opts := options.Replace().SetUpsert(true)
+	_, err := r.collection.ReplaceOne(ctx, bson.M{"_id": policy.Type}, policy, opts)
+	if err != nil {
+		return models.SLAPolicy{}, apierror.NewError(apierror.Internal, "error al guardar la política de SLA: "+err.Error())
+	}
+
+	return policy, nil
+}
+
+// ExistsByType indica si el tipo de evento indicado tiene una política configurada
+func (r *slaPolicyRepository) ExistsByType(ctx context.Context, eventType models.EventType) (bool, error) {
+	count, err := r.collection.CountDocuments(ctx, bson.M{"_id": eventType}, options.Count().SetLimit(1))
+	if err != nil {
+		return false, apierror.NewError(apierror.Internal, "error al buscar la política de SLA del tipo: "+err.Error())
+	}
+
+	return count > 0, nil
+}
+
+// Delete elimina la política configurada de un tipo de evento
+func (r *slaPolicyRepository) Delete(ctx context.Context, eventType models.EventType) error {
+	result, err := r.collection.DeleteOne(ctx, bson.M{"_id": eventType})
+	if err != nil {
+		return apierror.NewError(apierror.Internal, "error al eliminar la política de SLA: "+err.Error())
+	}
+
+	if result.DeletedCount == 0 {
+		return apierror.NewError(apierror.NotFound, "política de SLA no encontrada")
+	}
+
+	return nil
+}
@@ -0,0 +1,211 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"events-api/internal/apierror"
+	"events-api/internal/config"
+	"events-api/internal/models"
+	"events-api/pkg/database"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// TeamRepository define las operaciones del repositorio de equipos
+type TeamRepository interface {
+	FindAll(ctx context.Context) ([]models.Team, error)
+	FindAutoAssign(ctx context.Context) ([]models.Team, error)
+	FindByID(ctx context.Context, id string) (models.Team, error)
+	Create(ctx context.Context, team models.Team) (models.Team, error)
+	Update(ctx context.Context, id string, team models.Team) (models.Team, error)
+	Delete(ctx context.Context, id string) error
+	ExistsByType(ctx context.Context, eventType models.EventType) (bool, error)
+	NextMember(ctx context.Context, id string) (string, error)
+	AdvanceRotation(ctx context.Context, id string, count int64) error
+}
+
+// teamRepository implementa TeamRepository
+type teamRepository struct {
+	collection *mongo.Collection
+}
+
+// NewTeamRepository crea una nueva instancia de TeamRepository
+func NewTeamRepository(client *mongo.Client, cfg *config.Config) TeamRepository {
+	collection := database.GetCollection(client, cfg, cfg.TeamsCollection)
+	return &teamRepository{
+		collection: collection,
+	}
+}
+
+// FindAll recupera todos los equipos por orden de creación
+func (r *teamRepository) FindAll(ctx context.Context) ([]models.Team, error) {
+	return r.find(ctx, bson.M{})
+}
+
+// FindAutoAssign recupera los equipos con asignación automática por orden de creación
+func (r *teamRepository) FindAutoAssign(ctx context.Context) ([]models.Team, error) {
+	return r.find(ctx, bson.M{"auto_assign": true})
+}
+
+// FindByID recupera un equipo por su ID
+func (r *teamRepository) FindByID(ctx context.Context, id string) (models.Team, error) {
+	objectID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return models.Team{}, apierror.NewError(apierror.BadRequest, "ID de equipo inválido")
+	}
+
+	var team models.Team
+	err = r.collection.FindOne(ctx, bson.M{"_id": objectID}).Decode(&team)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return models.Team{}, apierror.NewError(apierror.NotFound, "equipo no encontrado")
+		}
+		return models.Team{}, apierror.NewError(apierror.Internal, "error al buscar el equipo: "+err.Error())
+	}
+
+	return team, nil
+}
+
+// Create crea un nuevo equipo
+func (r *teamRepository) Create(ctx context.Context, team models.Team) (models.Team, error) {
+	now := time.Now()
+
+	team.CreatedAt = now
+	team.UpdatedAt = now
+
+	if team.ID.IsZero() {
+		team.ID = primitive.NewObjectID()
+	}
+
+	_, err := r.collection.InsertOne(ctx, team)
+	if err != nil {
+		return models.Team{}, apierror.NewError(apierror.Internal, "error al crear el equipo: "+err.Error())
+	}
+
+	return team, nil
+}
+
+// Update actualiza un equipo existente sin modificar su contador de asignaciones
+func (r *teamRepository) Update(ctx context.Context, id string, team models.Team) (models.Team, error) {
+	objectID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return models.Team{}, apierror.NewError(apierror.BadRequest, "ID de equipo inválido")
+	}
+
+	update := bson.M{
+		"$set": bson.M{
+			"name":        team.Name,
+			"members":     team.Members,
+			"event_types": team.EventTypes,
+			"auto_assign": team.AutoAssign,
+			"updated_at":  time.Now(),
+		},
+	}
+
+	result, err := r.collection.UpdateOne(ctx, bson.M{"_id": objectID}, update)
+	if err != nil {
+		return models.Team{}, apierror.NewError(apierror.Internal, "error al actualizar el equipo: "+err.Error())
+	}
+
+	if result.MatchedCount == 0 {
+		return models.Team{}, apierror.NewError(apierror.NotFound, "equipo no encontrado")
+	}
+
+	return r.FindByID(ctx, id)
+}
+
+// ExistsByType indica si algún equipo atiende el tipo de evento indicado
+func (r *teamRepository) ExistsByType(ctx context.Context, eventType models.EventType) (bool, error) {
+	count, err := r.collection.CountDocuments(ctx, bson.M{"event_types": eventType}, options.Count().SetLimit(1))
+	if err != nil {
+		return false, apierror.NewError(apierror.Internal, "error al buscar los equipos del tipo: "+err.Error())
+	}
+
+	return count > 0, nil
+}
+
+// Delete elimina un equipo
+func (r *teamRepository) Delete(ctx context.Context, id string) error {
+	objectID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return apierror.NewError(apierror.BadRequest, "ID de equipo inválido")
+	}
+
+	result, err := r.collection.DeleteOne(ctx, bson.M{"_id": objectID})
+	if err != nil {
+		return apierror.NewError(apierror.Internal, "error al eliminar el equipo: "+err.Error())
+	}
+
+	if result.DeletedCount == 0 {
+		return apierror.NewError(apierror.NotFound, "equipo no encontrado")
+	}
+
+	return nil
+}
+
+// NextMember devuelve el miembro del equipo al que le corresponde el siguiente evento. El
+// contador de asignaciones se incrementa de forma atómica, por lo que dos asignaciones
+// simultáneas reciben miembros distintos.
+func (r *teamRepository) NextMember(ctx context.Context, id string) (string, error) {
+	objectID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return "", apierror.NewError(apierror.BadRequest, "ID de equipo inválido")
+	}
+
+	filter := bson.M{"_id": objectID, "members.0": bson.M{"$exists": true}}
+	update := bson.M{"$inc": bson.M{"assignment_count": 1}}
+	opts := options.FindOneAndUpdate().SetReturnDocument(options.Before)
+
+	var team models.Team
+	err = r.collection.FindOneAndUpdate(ctx, filter, update, opts).Decode(&team)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			if _, findErr := r.FindByID(ctx, id); findErr != nil {
+				return "", findErr
+			}
+			return "", apierror.NewError(apierror.ValidationFail, "el equipo no tiene miembros")
+		}
+		return "", apierror.NewError(apierror.Internal, "error al asignar el evento: "+err.Error())
+	}
+
+	return team.Members[team.AssignmentCount%int64(len(team.Members))], nil
+}
+
+// AdvanceRotation suma count al número de asignaciones de un equipo, consumiendo los turnos
+// usados por una operación masiva
+func (r *teamRepository) AdvanceRotation(ctx context.Context, id string, count int64) error {
+	objectID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return apierror.NewError(apierror.BadRequest, "ID de equipo inválido")
+	}
+
+	_, err = r.collection.UpdateOne(ctx, bson.M{"_id": objectID}, bson.M{"$inc": bson.M{"assignment_count": count}})
+	if err != nil {
+		return apierror.NewError(apierror.Internal, "error al actualizar los turnos del equipo: "+err.Error())
+	}
+
+	return nil
+}
+
+// find recupera los equipos que cumplen el filtro por orden de creación
+func (r *teamRepository) find(ctx context.Context, filter bson.M) ([]models.Team, error) {
+	opts := options.Find().SetSort(bson.D{{Key: "created_at", Value: 1}, {Key: "_id", Value: 1}})
+
+	cursor, err := r.collection.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var teams []models.Team
+	if err := cursor.All(ctx, &teams); err != nil {
+		return nil, err
+	}
+
+	return teams, nil
+}
@@ -0,0 +1,250 @@
+package services
+
+import (
+	"context"
+	"log"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"events-api/internal/actor"
+	"events-api/internal/apierror"
+	"events-api/internal/models"
+	"events-api/internal/repositories"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// mentionPattern reconoce las menciones a usuarios (@usuario) en el cuerpo de un comentario
+var mentionPattern = regexp.MustCompile(`(?:^|[^\w@])@([\p{L}\p{N}_.-]+)`)
+
+// CommentService define las operaciones del servicio de comentarios de eventos
+type CommentService interface {
+	GetComments(ctx context.Context, eventID string, opts models.ListOptions) (models.CommentListResponse, error)
+	GetComment(ctx context.Context, eventID string, id string) (models.Comment, error)
+	CreateComment(ctx context.Context, eventID string, req models.CreateCommentRequest) (models.Comment, error)
+	UpdateComment(ctx context.Context, eventID string, id string, req models.UpdateCommentRequest) (models.Comment, error)
+	DeleteComment(ctx context.Context, eventID string, id string) error
+	ArchiveEventComments(ctx context.Context, eventIDs ...primitive.ObjectID)
+	RestoreEventComments(ctx context.Context, eventID primitive.ObjectID)
+	PurgeEventComments(ctx context.Context, eventIDs ...primitive.ObjectID) (int64, error)
+}
+
+// commentService implementa CommentService
+type commentService struct {
+	repository      repositories.CommentRepository
+	eventRepository repositories.EventRepository
+}
+
+// NewCommentService crea una nueva instancia de CommentService
+func NewCommentService(repository repositories.CommentRepository, eventRepository repositories.EventRepository) CommentService {
+	return &commentService{
+		repository:      repository,
+		eventRepository: eventRepository,
+	}
+}
+
+// GetComments recupera una página de los comentarios de un evento
+func (s *commentService) GetComments(ctx context.Context, eventID string, opts models.ListOptions) (models.CommentListResponse, error) {
+	event, err := s.eventRepository.FindByID(ctx, eventID)
+	if err != nil {
+		return models.CommentListResponse{}, err
+	}
+
+	comments, total, err := s.repository.FindByEventID(ctx, event.ID, opts)
+	if err != nil {
+		return models.CommentListResponse{}, err
+	}
+
+	if comments == nil {
+		comments = []models.Comment{}
+	}
+
+	return models.CommentListResponse{
+		Data:       comments,
+		Pagination: models.NewPagination(opts, total),
+	}, nil
+}
+
+// GetComment recupera un comentario de un evento
+func (s *commentService) GetComment(ctx context.Context, eventID string, id string) (models.Comment, error) {
+	event, err := s.eventRepository.FindByID(ctx, eventID)
+	if err != nil {
+		return models.Comment{}, err
+	}
+
+	return s.repository.FindByID(ctx, event.ID, id)
+}
+
+// CreateComment publica un comentario en el hilo de un evento con el usuario del contexto como autor
+func (s *commentService) CreateComment(ctx context.Context, eventID string, req models.CreateCommentRequest) (models.Comment, error) {
+	author, err := commentAuthor(ctx)
+	if err != nil {
+		return models.Comment{}, err
+	}
+
+	body, err := normalizeCommentBody(req.Body)
+	if err != nil {
+		return models.Comment{}, err
+	}
+
+	event, err := s.eventRepository.FindByID(ctx, eventID)
+	if err != nil {
+		return models.Comment{}, err
+	}
+
+	comment, err := s.repository.Create(ctx, models.Comment{
+		EventID:  event.ID,
+		Author:   author,
+		Body:     body,
+		Mentions: extractMentions(body),
+	})
+	if err != nil {
+		return models.Comment{}, err
+	}
+
+	if err := s.eventRepository.IncrementCommentCount(ctx, event.ID, 1); err != nil {
+		log.Printf("Error al actualizar el número de comentarios del evento %s: %v\n", event.ID.Hex(), err)
+	}
+
+	return comment, nil
+}
+
+// UpdateComment edita un comentario conservando la versión anterior en su historial de ediciones.
+// Solo el autor puede editar un comentario.
+func (s *commentService) UpdateComment(ctx context.Context, eventID string, id string, req models.UpdateCommentRequest) (models.Comment, error) {
+	body, err := normalizeCommentBody(req.Body)
+	if err != nil {
+		return models.Comment{}, err
+	}
+
+	comment, err := s.findOwnComment(ctx, eventID, id)
+	if err != nil {
+		return models.Comment{}, err
+	}
+
+	if comment.Body == body {
+		return comment, nil
+	}
+
+	edit := models.CommentEdit{
+		Body:     comment.Body,
+		EditedBy: actor.FromContext(ctx),
+		EditedAt: time.Now(),
+	}
+
+	comment.Body = body
+	comment.Mentions = extractMentions(body)
+
+	return s.repository.Update(ctx, comment, edit)
+}
+
+// DeleteComment elimina un comentario. Solo el autor puede eliminar un comentario.
+func (s *commentService) DeleteComment(ctx context.Context, eventID string, id string) error {
+	comment, err := s.findOwnComment(ctx, eventID, id)
+	if err != nil {
+		return err
+	}
+
+	if err := s.repository.Delete(ctx, comment); err != nil {
+		return err
+	}
+
+	if err := s.eventRepository.IncrementCommentCount(ctx, comment.EventID, -1); err != nil {
+		log.Printf("Error al actualizar el número de comentarios del evento %s: %v\n", comment.EventID.Hex(), err)
+	}
+
+	return nil
+}
+
+// ArchiveEventComments archiva los comentarios de los eventos enviados a la papelera. Un fallo
+// no revierte el borrado de los eventos y solo se deja constancia en el log.
+func (s *commentService) ArchiveEventComments(ctx context.Context, eventIDs ...primitive.ObjectID) {
+	if len(eventIDs) == 0 {
+		return
+	}
+
+	if err := s.repository.ArchiveByEventIDs(ctx, eventIDs, time.Now()); err != nil {
+		log.Printf("Error al archivar los comentarios de %d eventos: %v\n", len(eventIDs), err)
+	}
+}
+
+// RestoreEventComments recupera los comentarios archivados de un evento restaurado de la papelera
+func (s *commentService) RestoreEventComments(ctx context.Context, eventID primitive.ObjectID) {
+	if err := s.repository.RestoreByEventID(ctx, eventID); err != nil {
+		log.Printf("Error al restaurar los comentarios del evento %s: %v\n", eventID.Hex(), err)
+	}
+}
+
+// PurgeEventComments elimina definitivamente los comentarios de los eventos purgados de la papelera
+func (s *commentService) PurgeEventComments(ctx context.Context, eventIDs ...primitive.ObjectID) (int64, error) {
+	if len(eventIDs) == 0 {
+		return 0, nil
+	}
+
+	return s.repository.DeleteByEventIDs(ctx, eventIDs)
+}
+
+// findOwnComment recupera un comentario de un evento y verifica que el usuario del contexto sea su autor
+func (s *commentService) findOwnComment(ctx context.Context, eventID string, id string) (models.Comment, error) {
+	author, err := commentAuthor(ctx)
+	if err != nil {
+		return models.Comment{}, err
+	}
+
+	comment, err := s.GetComment(ctx, eventID, id)
+	if err != nil {
+		return models.Comment{}, err
+	}
+
+	if comment.Author != author {
+		return models.Comment{}, apierror.NewError(apierror.Forbidden, "solo el autor puede modificar el comentario")
+	}
+
+	return comment, nil
+}
+
+// commentAuthor devuelve el usuario del contexto. Publicar, editar o eliminar comentarios exige
+// identificar al usuario para que los anónimos no puedan modificar los comentarios de otros.
+func commentAuthor(ctx context.Context) (string, error) {
+	author := actor.FromContext(ctx)
+	if author == actor.Anonymous {
+		return "", apierror.NewError(apierror.Unauthorized, "indique el usuario en la cabecera X-User-ID para publicar o modificar comentarios")
+	}
+	return author, nil
+}
+
+// normalizeCommentBody elimina los espacios exteriores del cuerpo de un comentario y valida su longitud
+func normalizeCommentBody(body string) (string, error) {
+	body = strings.TrimSpace(body)
+	if body == "" {
+		return "", apierror.NewError(apierror.ValidationFail, "el comentario no puede estar vacío")
+	}
+
+	if utf8.RuneCountInString(body) > models.MaxCommentLength {
+		return "", apierror.NewError(apierror.ValidationFail, "el comentario admite como máximo "+strconv.Itoa(models.MaxCommentLength)+" caracteres")
+	}
+
+	return body, nil
+}
+
+// extractMentions devuelve los usuarios mencionados en el cuerpo de un comentario, sin
+// repetidos y en el orden en que aparecen
+func extractMentions(body string) []string {
+	mentions := []string{}
+	seen := make(map[string]bool)
+
+	for _, match := range mentionPattern.FindAllStringSubmatch(body, -1) {
+		// Un punto final pertenece a la frase, no al usuario
+		mention := strings.TrimRight(match[1], ".")
+		if mention == "" || seen[mention] {
+			continue
+		}
+		seen[mention] = true
+		mentions = append(mentions, mention)
+	}
+
+	return mentions
+}
@@ -0,0 +1,186 @@
+package services
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	"events-api/internal/apierror"
+	"events-api/internal/models"
+	"events-api/internal/repositories"
+)
+
+// EscalationService define las operaciones del servicio de políticas de escalado
+type EscalationService interface {
+	GetAllPolicies(ctx context.Context) ([]models.EscalationPolicy, error)
+	GetPolicyByID(ctx context.Context, id string) (models.EscalationPolicy, error)
+	CreatePolicy(ctx context.Context, req models.CreateEscalationPolicyRequest) (models.EscalationPolicy, error)
+	UpdatePolicy(ctx context.Context, id string, req models.UpdateEscalationPolicyRequest) (models.EscalationPolicy, error)
+	DeletePolicy(ctx context.Context, id string) error
+	GetEnabledPolicies(ctx context.Context) (map[models.EventType]models.EscalationPolicy, error)
+}
+
+// escalationService implementa EscalationService
+type escalationService struct {
+	repository       repositories.EscalationPolicyRepository
+	eventRepository  repositories.EventRepository
+	eventTypeService EventTypeService
+}
+
+// NewEscalationService crea una nueva instancia de EscalationService
+func NewEscalationService(repository repositories.EscalationPolicyRepository, eventRepository repositories.EventRepository, eventTypeService EventTypeService) EscalationService {
+	return &escalationService{
+		repository:       repository,
+		eventRepository:  eventRepository,
+		eventTypeService: eventTypeService,
+	}
+}
+
+// GetAllPolicies recupera todas las políticas de escalado
+func (s *escalationService) GetAllPolicies(ctx context.Context) ([]models.EscalationPolicy, error) {
+	return s.repository.FindAll(ctx)
+}
+
+// GetPolicyByID recupera una política de escalado por su ID
+func (s *escalationService) GetPolicyByID(ctx context.Context, id string) (models.EscalationPolicy, error) {
+	return s.repository.FindByID(ctx, id)
+}
+
+// CreatePolicy crea una nueva política de escalado
+func (s *escalationService) CreatePolicy(ctx context.Context, req models.CreateEscalationPolicyRequest) (models.EscalationPolicy, error) {
+	policy := models.EscalationPolicy{
+		Name:      strings.TrimSpace(req.Name),
+		EventType: req.EventType,
+		Enabled:   true,
+		Levels:    normalizeEscalationLevels(req.Levels),
+	}
+
+	if req.Enabled != nil {
+		policy.Enabled = *req.Enabled
+	}
+
+	if err := s.validateEscalationPolicy(ctx, policy); err != nil {
+		return models.EscalationPolicy{}, err
+	}
+
+	created, err := s.repository.Create(ctx, policy)
+	if err != nil {
+		return models.EscalationPolicy{}, err
+	}
+
+	// Los eventos del tipo se evalúan de nuevo con la nueva política
+	if err := s.eventRepository.ResetEscalationDue(ctx, created.EventType); err != nil {
+		return models.EscalationPolicy{}, err
+	}
+
+	return created, nil
+}
+
+// UpdatePolicy actualiza una política de escalado existente
+func (s *escalationService) UpdatePolicy(ctx context.Context, id string, req models.UpdateEscalationPolicyRequest) (models.EscalationPolicy, error) {
+	policy, err := s.repository.FindByID(ctx, id)
+	if err != nil {
+		return models.EscalationPolicy{}, err
+	}
+	previousType := policy.EventType
+
+	// Actualizar solo los campos proporcionados
+	if req.Name != nil {
+		policy.Name = strings.TrimSpace(*req.Name)
+	}
+
+	if req.EventType != nil {
+		policy.EventType = *req.EventType
+	}
+
+	if req.Enabled != nil {
+		policy.Enabled = *req.Enabled
+	}
+
+	if req.Levels != nil {
+		policy.Levels = normalizeEscalationLevels(*req.Levels)
+	}
+
+	if err := s.validateEscalationPolicy(ctx, policy); err != nil {
+		return models.EscalationPolicy{}, err
+	}
+
+	updated, err := s.repository.Update(ctx, id, policy)
+	if err != nil {
+		return models.EscalationPolicy{}, err
+	}
+
+	// Los vencimientos calculados con la política anterior dejan de ser válidos
+	eventTypes := []models.EventType{previousType}
+	if updated.EventType != previousType {
+		eventTypes = append(eventTypes, updated.EventType)
+	}
+	for _, eventType := range eventTypes {
+		if err := s.eventRepository.ResetEscalationDue(ctx, eventType); err != nil {
+			return models.EscalationPolicy{}, err
+		}
+	}
+
+	return updated, nil
+}
+
+// DeletePolicy elimina una política de escalado
+func (s *escalationService) DeletePolicy(ctx context.Context, id string) error {
+	return s.repository.Delete(ctx, id)
+}
+
+// GetEnabledPolicies devuelve las políticas habilitadas por tipo de evento
+func (s *escalationService) GetEnabledPolicies(ctx context.Context) (map[models.EventType]models.EscalationPolicy, error) {
+	policies, err := s.repository.FindEnabled(ctx)
+	if err != nil {
+		return nil, apierror.NewError(apierror.Internal, "error al cargar las políticas de escalado: "+err.Error())
+	}
+
+	byType := make(map[models.EventType]models.EscalationPolicy, len(policies))
+	for _, policy := range policies {
+		byType[policy.EventType] = policy
+	}
+	return byType, nil
+}
+
+// validateEscalationPolicy valida el contenido de una política de escalado. Los niveles deben
+// tener destinatarios y retrasos estrictamente crecientes.
+func (s *escalationService) validateEscalationPolicy(ctx context.Context, policy models.EscalationPolicy) error {
+	if policy.Name == "" {
+		return apierror.NewError(apierror.ValidationFail, "el nombre de la política de escalado es obligatorio")
+	}
+
+	if _, err := s.eventTypeService.ValidateEventType(ctx, policy.EventType, false); err != nil {
+		return err
+	}
+
+	if len(policy.Levels) == 0 || len(policy.Levels) > models.MaxEscalationLevels {
+		return apierror.NewError(apierror.ValidationFail, "la política de escalado debe tener entre 1 y "+strconv.Itoa(models.MaxEscalationLevels)+" niveles")
+	}
+
+	previousDelay := 0
+	for i, level := range policy.Levels {
+		number := strconv.Itoa(i + 1)
+
+		if level.DelayMinutes <= previousDelay {
+			return apierror.NewError(apierror.ValidationFail, "el retraso del nivel "+number+" debe ser positivo y mayor que el del nivel anterior")
+		}
+		previousDelay = level.DelayMinutes
+
+		if len(level.Targets) == 0 {
+			return apierror.NewError(apierror.ValidationFail, "el nivel "+number+" no tiene destinatarios")
+		}
+	}
+
+	return nil
+}
+
+// normalizeEscalationLevels elimina espacios y destinatarios vacíos o repetidos de los niveles
+func normalizeEscalationLevels(levels []models.EscalationLevel) []models.EscalationLevel {
+	normalized := make([]models.EscalationLevel, 0, len(levels))
+	for _, level := range levels {
+		level.Targets = normalizeMembers(level.Targets)
+		normalized = append(normalized, level)
+	}
+	return normalized
+}
@@ -0,0 +1,299 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"log"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"events-api/internal/actor"
+	"events-api/internal/apierror"
+	"events-api/internal/models"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// maxAttachmentNameLength es la longitud máxima, en caracteres, del nombre de un archivo adjunto
+const maxAttachmentNameLength = 255
+
+// GetAttachments recupera los metadatos de los archivos adjuntos de un evento
+func (s *eventService) GetAttachments(ctx context.Context, id string) ([]models.Attachment, error) {
+	event, err := s.repository.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if event.Attachments == nil {
+		return []models.Attachment{}, nil
+	}
+
+	return event.Attachments, nil
+}
+
+// GetAttachment recupera los metadatos de un archivo adjunto de un evento y abre su contenido.
+// Quien llama debe cerrar el contenido.
+func (s *eventService) GetAttachment(ctx context.Context, id string, attachmentID string) (models.Attachment, io.ReadSeekCloser, error) {
+	event, err := s.repository.FindByID(ctx, id)
+	if err != nil {
+		return models.Attachment{}, nil, err
+	}
+
+	attachment, err := findAttachment(event, attachmentID)
+	if err != nil {
+		return models.Attachment{}, nil, err
+	}
+
+	content, err := s.blobStore.Open(ctx, attachment.StorageKey)
+	if err != nil {
+		if _, ok := apierror.AsError(err); ok {
+			return models.Attachment{}, nil, err
+		}
+		return models.Attachment{}, nil, apierror.NewError(apierror.Internal, "error al abrir el archivo adjunto: "+err.Error())
+	}
+
+	return attachment, content, nil
+}
+
+// UploadAttachment guarda un archivo en el almacén y añade sus metadatos al evento. El tamaño y el
+// tipo de contenido se validan contra los límites de la configuración.
+func (s *eventService) UploadAttachment(ctx context.Context, id string, file *multipart.FileHeader) (models.Attachment, error) {
+	if file.Size > s.cfg.MaxAttachmentSize {
+		return models.Attachment{}, s.attachmentTooLarge()
+	}
+
+	event, err := s.repository.FindByID(ctx, id)
+	if err != nil {
+		return models.Attachment{}, err
+	}
+
+	if len(event.Attachments) >= models.MaxAttachmentsPerEvent {
+		return models.Attachment{}, apierror.NewError(apierror.ValidationFail, "el evento admite como máximo "+strconv.Itoa(models.MaxAttachmentsPerEvent)+" archivos adjuntos")
+	}
+
+	content, err := file.Open()
+	if err != nil {
+		return models.Attachment{}, apierror.NewError(apierror.BadRequest, "no se pudo leer el archivo: "+err.Error())
+	}
+	defer content.Close()
+
+	// Los primeros bytes determinan el tipo de contenido real del archivo
+	head := make([]byte, 512)
+	n, err := io.ReadFull(content, head)
+	if err != nil && err != io.ErrUnexpectedEOF && err != io.EOF {
+		return models.Attachment{}, apierror.NewError(apierror.BadRequest, "no se pudo leer el archivo: "+err.Error())
+	}
+	head = head[:n]
+
+	fileName := normalizeAttachmentName(file.Filename)
+	contentType, err := detectContentType(file.Header.Get("Content-Type"), fileName, head)
+	if err != nil {
+		return models.Attachment{}, err
+	}
+	if !s.isAllowedContentType(contentType) {
+		return models.Attachment{}, apierror.NewError(apierror.UnsupportedMediaType, "tipo de archivo no permitido: "+contentType)
+	}
+
+	attachment := models.Attachment{
+		ID:          primitive.NewObjectID(),
+		FileName:    fileName,
+		ContentType: contentType,
+		UploadedBy:  actor.FromContext(ctx),
+		UploadedAt:  time.Now(),
+	}
+	attachment.StorageKey = event.ID.Hex() + "/" + attachment.ID.Hex()
+
+	// El tamaño declarado en la solicitud no es fiable: se lee como máximo un byte más del límite
+	// para detectar los archivos que lo superan
+	hash := sha256.New()
+	reader := io.TeeReader(io.LimitReader(io.MultiReader(bytes.NewReader(head), content), s.cfg.MaxAttachmentSize+1), hash)
+
+	size, err := s.blobStore.Put(ctx, attachment.StorageKey, reader)
+	if err != nil {
+		s.deleteBlobs(ctx, attachment)
+		return models.Attachment{}, apierror.NewError(apierror.Internal, "error al guardar el archivo adjunto: "+err.Error())
+	}
+
+	if size > s.cfg.MaxAttachmentSize {
+		s.deleteBlobs(ctx, attachment)
+		return models.Attachment{}, s.attachmentTooLarge()
+	}
+
+	attachment.Size = size
+	attachment.Checksum = hex.EncodeToString(hash.Sum(nil))
+
+	updatedEvent, err := s.repository.AddAttachment(ctx, event.ID, attachment)
+	if err != nil {
+		s.deleteBlobs(ctx, attachment)
+		return models.Attachment{}, err
+	}
+
+	s.recordHistory(ctx, models.OperationAttach, &event, &updatedEvent)
+
+	return attachment, nil
+}
+
+// DeleteAttachment elimina un archivo adjunto de un evento junto a su contenido
+func (s *eventService) DeleteAttachment(ctx context.Context, id string, attachmentID string) error {
+	event, err := s.repository.FindByID(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	attachment, err := findAttachment(event, attachmentID)
+	if err != nil {
+		return err
+	}
+
+	updatedEvent, err := s.repository.RemoveAttachment(ctx, event.ID, attachment.ID)
+	if err != nil {
+		return err
+	}
+
+	s.recordHistory(ctx, models.OperationDetach, &event, &updatedEvent)
+	s.deleteBlobs(ctx, attachment)
+
+	return nil
+}
+
+// deleteBlobs elimina del almacén el contenido de los adjuntos indicados. Un fallo solo deja
+// constancia en el log: el contenido huérfano no es accesible desde la API.
+func (s *eventService) deleteBlobs(ctx context.Context, attachments ...models.Attachment) {
+	for _, attachment := range attachments {
+		if err := s.blobStore.Delete(ctx, attachment.StorageKey); err != nil {
+			log.Printf("Error al eliminar el contenido del adjunto %s: %v\n", attachment.StorageKey, err)
+		}
+	}
+}
+
+// isAllowedContentType indica si el tipo de contenido está en la lista de tipos permitidos, que
+// admite comodines de subtipo como "image/*"
+func (s *eventService) isAllowedContentType(contentType string) bool {
+	for _, allowed := range s.cfg.AllowedAttachmentTypes {
+		allowed = strings.ToLower(allowed)
+		if allowed == contentType || (strings.HasSuffix(allowed, "/*") && strings.HasPrefix(contentType, strings.TrimSuffix(allowed, "*"))) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// attachmentTooLarge construye el error de un archivo que supera el tamaño máximo
+func (s *eventService) attachmentTooLarge() error {
+	return apierror.NewError(apierror.PayloadTooLarge, "el archivo supera el tamaño máximo de "+strconv.FormatInt(s.cfg.MaxAttachmentSize, 10)+" bytes")
+}
+
+// findAttachment busca un archivo adjunto de un evento por su ID
+func findAttachment(event models.Event, attachmentID string) (models.Attachment, error) {
+	objectID, err := primitive.ObjectIDFromHex(attachmentID)
+	if err != nil {
+		return models.Attachment{}, apierror.NewError(apierror.BadRequest, "ID de archivo adjunto inválido")
+	}
+
+	for _, attachment := range event.Attachments {
+		if attachment.ID == objectID {
+			return attachment, nil
+		}
+	}
+
+	return models.Attachment{}, apierror.NewError(apierror.NotFound, "archivo adjunto no encontrado")
+}
+
+// detectContentType determina el tipo de contenido de un archivo a partir de sus primeros bytes.
+// El tipo declarado por el cliente y el que corresponde a la extensión del nombre deben coincidir
+// con el detectado; solo sirven para precisar el tipo de un contenido de texto, como JSON o CSV,
+// que los primeros bytes no distinguen. Se devuelve sin parámetros y en minúsculas.
+func detectContentType(declared string, fileName string, head []byte) (string, error) {
+	sniffed := parseContentType(http.DetectContentType(head))
+
+	extension := filepath.Ext(fileName)
+	claims := []struct {
+		contentType string
+		source      string
+	}{
+		{parseContentType(declared), "el tipo declarado"},
+		{parseContentType(mime.TypeByExtension(extension)), "la extensión " + extension},
+	}
+
+	contentType := sniffed
+	for _, claim := range claims {
+		// Un tipo desconocido o genérico no aporta información sobre el contenido
+		if claim.contentType == "" || claim.contentType == "application/octet-stream" {
+			continue
+		}
+
+		if !contentTypeMatches(claim.contentType, sniffed) {
+			return "", apierror.NewError(apierror.UnsupportedMediaType, claim.source+" ("+claim.contentType+") no corresponde al contenido del archivo ("+sniffed+")")
+		}
+
+		if contentType == sniffed {
+			contentType = claim.contentType
+		}
+	}
+
+	return contentType, nil
+}
+
+// contentTypeMatches indica si el tipo indicado por el cliente es compatible con el detectado en
+// el contenido. Los primeros bytes de un texto solo revelan que es texto, por lo que cualquier
+// tipo de texto es compatible con text/plain.
+func contentTypeMatches(claimed string, sniffed string) bool {
+	switch {
+	case claimed == sniffed:
+		return true
+	case sniffed == "text/plain":
+		return isTextContentType(claimed)
+	case sniffed == "text/xml":
+		return claimed == "application/xml" || strings.HasSuffix(claimed, "+xml")
+	default:
+		return false
+	}
+}
+
+// isTextContentType indica si el tipo de contenido corresponde a un formato de texto
+func isTextContentType(contentType string) bool {
+	switch {
+	case strings.HasPrefix(contentType, "text/"):
+		return true
+	case contentType == "application/json" || contentType == "application/xml" || contentType == "application/javascript":
+		return true
+	default:
+		return strings.HasSuffix(contentType, "+json") || strings.HasSuffix(contentType, "+xml")
+	}
+}
+
+// parseContentType devuelve el tipo de contenido sin parámetros y en minúsculas, o una cadena
+// vacía si no es válido
+func parseContentType(contentType string) string {
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return ""
+	}
+
+	return strings.ToLower(mediaType)
+}
+
+// normalizeAttachmentName elimina la ruta y los espacios exteriores del nombre de un archivo y
+// limita su longitud
+func normalizeAttachmentName(name string) string {
+	name = strings.TrimSpace(filepath.Base(strings.ReplaceAll(name, "\\", "/")))
+	if name == "" || name == "." || name == "/" {
+		return "archivo"
+	}
+
+	if utf8.RuneCountInString(name) > maxAttachmentNameLength {
+		name = string([]rune(name)[:maxAttachmentNameLength])
+	}
+
+	return name
+}
@@ -0,0 +1,55 @@
+package services
+
+import (
+	"testing"
+
+	"events-api/internal/apierror"
+)
+
+func TestDetectContentType(t *testing.T) {
+	png := []byte("\x89PNG\r\n\x1a\n\x00\x00\x00\rIHDR")
+	pdf := []byte("%PDF-1.7\n")
+	jsonText := []byte(`{"source": "cpu"}`)
+
+	tests := []struct {
+		name     string
+		declared string
+		fileName string
+		head     []byte
+		want     string
+		// rejected indica que la subida se rechaza porque el tipo indicado no corresponde al contenido
+		rejected bool
+	}{
+		{"sin tipo declarado se usa el contenido", "", "captura", png, "image/png", false},
+		{"tipo declarado y extensión coinciden", "image/png; name=captura.png", "captura.png", png, "image/png", false},
+		{"tipo declarado genérico", "application/octet-stream", "captura.png", png, "image/png", false},
+		{"tipo declarado en mayúsculas", "Image/PNG", "captura", png, "image/png", false},
+		{"el tipo declarado precisa un texto", "application/json", "datos", jsonText, "application/json", false},
+		{"la extensión precisa un texto", "", "datos.json", jsonText, "application/json", false},
+		{"texto sin más información", "", "notas", []byte("reinicio a las 10:00"), "text/plain", false},
+		{"archivo vacío", "", "vacio", nil, "text/plain", false},
+		{"binario desconocido", "", "volcado", []byte{0x00, 0x01, 0x02, 0xff}, "application/octet-stream", false},
+		{"tipo declarado distinto del contenido", "image/png", "informe", pdf, "", true},
+		{"extensión distinta del contenido", "", "captura.png", pdf, "", true},
+		{"binario declarado como texto", "text/plain", "informe", pdf, "", true},
+		{"texto declarado como imagen", "image/png", "datos", jsonText, "", true},
+		{"binario desconocido declarado como imagen", "image/png", "volcado", []byte{0x00, 0x01, 0x02, 0xff}, "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := detectContentType(tt.declared, tt.fileName, tt.head)
+			if tt.rejected {
+				assertErrorType(t, err, apierror.UnsupportedMediaType)
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("detectContentType devolvió %v", err)
+			}
+			if got != tt.want {
+				t.Fatalf("detectContentType = %q, se esperaba %q", got, tt.want)
+			}
+		})
+	}
+}
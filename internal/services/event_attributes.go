@@ -0,0 +1,193 @@
+package services
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+
+	"events-api/internal/apierror"
+	"events-api/internal/models"
+
+	"github.com/santhosh-tekuri/jsonschema/v5"
+)
+
+// attributesField es el nombre del campo de los campos personalizados en los errores de validación
+const attributesField = "attributes"
+
+// compileAttributesSchema compila el esquema JSON de los campos personalizados de un tipo de
+// evento. Las referencias externas ($ref a otras URL o a archivos) no se resuelven.
+func compileAttributesSchema(code models.EventType, schema json.RawMessage) (*jsonschema.Schema, error) {
+	url := "mem:///event-types/" + string(code) + ".json"
+
+	compiler := jsonschema.NewCompiler()
+	compiler.AssertFormat = true
+	compiler.LoadURL = func(s string) (io.ReadCloser, error) {
+		return nil, errors.New("no se admiten referencias externas: " + s)
+	}
+
+	if err := compiler.AddResource(url, bytes.NewReader(schema)); err != nil {
+		return nil, err
+	}
+
+	return compiler.Compile(url)
+}
+
+// validateAttributesSchema verifica que el esquema de un tipo de evento sea un esquema JSON válido
+func validateAttributesSchema(code models.EventType, schema json.RawMessage) error {
+	if len(schema) > models.MaxAttributesSchemaSize {
+		return apierror.NewError(apierror.ValidationFail, "el esquema de los campos personalizados no puede superar "+strconv.Itoa(models.MaxAttributesSchemaSize)+" bytes")
+	}
+
+	if _, err := compileAttributesSchema(code, schema); err != nil {
+		return apierror.NewError(apierror.ValidationFail, "esquema de campos personalizados no válido: "+err.Error())
+	}
+
+	return nil
+}
+
+// validateAttributes valida los campos personalizados de un evento: las claves deben poder
+// usarse como rutas en MongoDB y, si el tipo declara un esquema, los valores deben cumplirlo.
+// Los errores indican la ruta de cada campo no válido.
+func validateAttributes(eventType models.EventType, schema *jsonschema.Schema, attributes map[string]interface{}) error {
+	// Los valores se normalizan a su representación JSON, ya procedan de la solicitud o de MongoDB
+	data, err := json.Marshal(attributes)
+	if err != nil {
+		return apierror.NewError(apierror.ValidationFail, "los campos personalizados no son válidos: "+err.Error())
+	}
+
+	if len(data) > models.MaxAttributesSize {
+		return apierror.NewError(apierror.ValidationFail, "los campos personalizados no pueden superar "+strconv.Itoa(models.MaxAttributesSize)+" bytes")
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+
+	var document interface{}
+	if err := decoder.Decode(&document); err != nil {
+		return apierror.NewError(apierror.ValidationFail, "los campos personalizados no son válidos: "+err.Error())
+	}
+
+	if details := validateAttributeKeys(attributesField, document, nil); len(details) > 0 {
+		return apierror.NewValidationError("los campos personalizados no son válidos", details)
+	}
+
+	if schema == nil {
+		return nil
+	}
+
+	// Un evento sin campos personalizados se valida como un objeto vacío
+	if document == nil {
+		document = map[string]interface{}{}
+	}
+
+	err = schema.Validate(document)
+	if err == nil {
+		return nil
+	}
+
+	var validationErr *jsonschema.ValidationError
+	if !errors.As(err, &validationErr) {
+		return apierror.NewError(apierror.ValidationFail, "no se pudieron validar los campos personalizados: "+err.Error())
+	}
+
+	return apierror.NewValidationError("los campos personalizados no cumplen el esquema del tipo de evento "+string(eventType), schemaFieldErrors(validationErr))
+}
+
+// validateAttributeKeys verifica de forma recursiva que las claves no estén vacías, no
+// contengan puntos y no empiecen por $
+func validateAttributeKeys(path string, value interface{}, details []apierror.FieldError) []apierror.FieldError {
+	switch value := value.(type) {
+	case map[string]interface{}:
+		keys := make([]string, 0, len(value))
+		for key := range value {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+
+		for _, key := range keys {
+			if key == "" || strings.Contains(key, ".") || strings.HasPrefix(key, "$") {
+				details = append(details, apierror.FieldError{
+					Field:   path,
+					Message: "clave no válida " + strconv.Quote(key) + ": no puede estar vacía, contener puntos ni empezar por $",
+				})
+				continue
+			}
+			details = validateAttributeKeys(path+"."+key, value[key], details)
+		}
+	case []interface{}:
+		for i, item := range value {
+			details = validateAttributeKeys(path+"."+strconv.Itoa(i), item, details)
+		}
+	}
+
+	return details
+}
+
+// schemaFieldErrors convierte los errores de validación del esquema en errores por campo. Solo
+// se incluyen los errores finales, que son los que identifican el campo y el motivo.
+func schemaFieldErrors(validationErr *jsonschema.ValidationError) []apierror.FieldError {
+	var details []apierror.FieldError
+	seen := make(map[apierror.FieldError]bool)
+
+	var collect func(err *jsonschema.ValidationError)
+	collect = func(err *jsonschema.ValidationError) {
+		if len(err.Causes) > 0 {
+			for _, cause := range err.Causes {
+				collect(cause)
+			}
+			return
+		}
+
+		detail := apierror.FieldError{
+			Field:   attributePath(err.InstanceLocation),
+			Message: err.Message,
+		}
+		if !seen[detail] {
+			seen[detail] = true
+			details = append(details, detail)
+		}
+	}
+	collect(validationErr)
+
+	return details
+}
+
+// attributesChanged indica si dos conjuntos de campos personalizados son distintos. Se comparan
+// por su representación JSON, ya que los valores leídos de MongoDB y los de la solicitud pueden
+// tener tipos distintos para un mismo valor.
+func attributesChanged(before, after map[string]interface{}) bool {
+	beforeData, beforeErr := json.Marshal(normalizeAttributes(before))
+	afterData, afterErr := json.Marshal(normalizeAttributes(after))
+	if beforeErr != nil || afterErr != nil {
+		return true
+	}
+	return !bytes.Equal(beforeData, afterData)
+}
+
+// normalizeAttributes descarta un objeto de campos personalizados vacío para no guardarlo
+func normalizeAttributes(attributes map[string]interface{}) map[string]interface{} {
+	if len(attributes) == 0 {
+		return nil
+	}
+	return attributes
+}
+
+// attributePath convierte un puntero JSON (/window/start) en la ruta del campo en la
+// solicitud (attributes.window.start)
+func attributePath(pointer string) string {
+	if pointer == "" {
+		return attributesField
+	}
+
+	segments := strings.Split(strings.TrimPrefix(pointer, "/"), "/")
+	for i, segment := range segments {
+		segment = strings.ReplaceAll(segment, "~1", "/")
+		segments[i] = strings.ReplaceAll(segment, "~0", "~")
+	}
+
+	return attributesField + "." + strings.Join(segments, ".")
+}
@@ -0,0 +1,279 @@
+package services
+
+import (
+	"encoding/json"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+
+	"events-api/internal/apierror"
+	"events-api/internal/models"
+)
+
+// maintenanceSchema es un esquema de prueba con campos obligatorios, anidados y con formato
+const maintenanceSchema = `{
+	"type": "object",
+	"required": ["window", "affectedSystems"],
+	"properties": {
+		"window": {
+			"type": "object",
+			"required": ["start", "end"],
+			"properties": {
+				"start": {"type": "string", "format": "date-time"},
+				"end": {"type": "string", "format": "date-time"}
+			}
+		},
+		"affectedSystems": {"type": "array", "items": {"type": "string"}, "minItems": 1},
+		"a/b": {"type": "integer"}
+	}
+}`
+
+func TestAttributePath(t *testing.T) {
+	tests := []struct {
+		name    string
+		pointer string
+		want    string
+	}{
+		{"raíz", "", "attributes"},
+		{"campo", "/source", "attributes.source"},
+		{"campo anidado", "/window/start", "attributes.window.start"},
+		{"elemento de una lista", "/affectedSystems/0", "attributes.affectedSystems.0"},
+		{"barra escapada", "/a~1b", "attributes.a/b"},
+		{"tilde escapada", "/a~0b", "attributes.a~b"},
+		{"tilde seguida de uno", "/a~01", "attributes.a~1"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := attributePath(tt.pointer); got != tt.want {
+				t.Fatalf("attributePath(%q) = %q, se esperaba %q", tt.pointer, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestValidateAttributes(t *testing.T) {
+	schema, err := compileAttributesSchema("MAINTENANCE", json.RawMessage(maintenanceSchema))
+	if err != nil {
+		t.Fatalf("no se pudo compilar el esquema: %v", err)
+	}
+
+	tests := []struct {
+		name       string
+		withSchema bool
+		attributes map[string]interface{}
+		// fields son los campos con error esperados, en orden; nil indica que la validación pasa
+		fields []string
+	}{
+		{
+			name:       "sin esquema admite cualquier campo",
+			attributes: map[string]interface{}{"free": map[string]interface{}{"form": 1}},
+		},
+		{
+			name:       "sin esquema rechaza claves con puntos",
+			attributes: map[string]interface{}{"a.b": 1},
+			fields:     []string{"attributes"},
+		},
+		{
+			name:       "sin esquema rechaza claves anidadas con $",
+			attributes: map[string]interface{}{"nested": []interface{}{map[string]interface{}{"$set": 1}}},
+			fields:     []string{"attributes.nested.0"},
+		},
+		{
+			name:       "cumple el esquema",
+			withSchema: true,
+			attributes: map[string]interface{}{
+				"window":          map[string]interface{}{"start": "2024-03-01T10:00:00Z", "end": "2024-03-01T12:00:00Z"},
+				"affectedSystems": []interface{}{"api"},
+			},
+		},
+		{
+			name:       "un evento sin campos incumple los obligatorios",
+			withSchema: true,
+			attributes: nil,
+			fields:     []string{"attributes"},
+		},
+		{
+			name:       "campo anidado obligatorio",
+			withSchema: true,
+			attributes: map[string]interface{}{
+				"window":          map[string]interface{}{"start": "2024-03-01T10:00:00Z"},
+				"affectedSystems": []interface{}{"api"},
+			},
+			fields: []string{"attributes.window"},
+		},
+		{
+			name:       "formato y tipo de varios campos",
+			withSchema: true,
+			attributes: map[string]interface{}{
+				"window":          map[string]interface{}{"start": "mañana", "end": "2024-03-01T12:00:00Z"},
+				"affectedSystems": []interface{}{"api", 3},
+			},
+			fields: []string{"attributes.affectedSystems.1", "attributes.window.start"},
+		},
+		{
+			name:       "clave con barra",
+			withSchema: true,
+			attributes: map[string]interface{}{
+				"window":          map[string]interface{}{"start": "2024-03-01T10:00:00Z", "end": "2024-03-01T12:00:00Z"},
+				"affectedSystems": []interface{}{"api"},
+				"a/b":             1.5,
+			},
+			fields: []string{"attributes.a/b"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var err error
+			if tt.withSchema {
+				err = validateAttributes("MAINTENANCE", schema, tt.attributes)
+			} else {
+				err = validateAttributes("MAINTENANCE", nil, tt.attributes)
+			}
+
+			if tt.fields == nil {
+				if err != nil {
+					t.Fatalf("validateAttributes devolvió %v", err)
+				}
+				return
+			}
+
+			assertErrorType(t, err, apierror.ValidationFail)
+			apiErr, _ := apierror.AsError(err)
+
+			fields := make([]string, 0, len(apiErr.Details))
+			for _, detail := range apiErr.Details {
+				if detail.Message == "" {
+					t.Errorf("el error del campo %s no tiene mensaje", detail.Field)
+				}
+				fields = append(fields, detail.Field)
+			}
+			sort.Strings(fields)
+
+			if !reflect.DeepEqual(fields, tt.fields) {
+				t.Fatalf("campos con error = %v, se esperaba %v", fields, tt.fields)
+			}
+		})
+	}
+}
+
+func TestValidateAttributesSize(t *testing.T) {
+	attributes := map[string]interface{}{"blob": strings.Repeat("x", models.MaxAttributesSize)}
+
+	assertErrorType(t, validateAttributes("INFO", nil, attributes), apierror.ValidationFail)
+}
+
+func TestValidateAttributesSchema(t *testing.T) {
+	tests := []struct {
+		name   string
+		schema string
+		valid  bool
+	}{
+		{"esquema válido", maintenanceSchema, true},
+		{"JSON no válido", `{"type": `, false},
+		{"tipo desconocido", `{"type": "fecha"}`, false},
+		{"referencia externa", `{"$ref": "https://example.com/schema.json"}`, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateAttributesSchema("MAINTENANCE", json.RawMessage(tt.schema))
+			if tt.valid {
+				if err != nil {
+					t.Fatalf("validateAttributesSchema devolvió %v", err)
+				}
+				return
+			}
+
+			assertErrorType(t, err, apierror.ValidationFail)
+		})
+	}
+}
+
+func TestBuiltinAttributesSchemas(t *testing.T) {
+	tests := []struct {
+		eventType  models.EventType
+		attributes map[string]interface{}
+		fields     []string
+	}{
+		{models.TypeAlert, map[string]interface{}{"source": "cpu", "threshold": 90}, nil},
+		{models.TypeAlert, map[string]interface{}{"source": "cpu"}, []string{"attributes"}},
+		{models.TypeAlert, map[string]interface{}{"source": "", "threshold": "alto"}, []string{"attributes.source", "attributes.threshold"}},
+		{models.TypeMaintenance, map[string]interface{}{
+			"window":          map[string]interface{}{"start": "2024-03-01T10:00:00Z", "end": "2024-03-01T12:00:00Z"},
+			"affectedSystems": []interface{}{"api"},
+		}, nil},
+		{models.TypeMaintenance, nil, []string{"attributes"}},
+		{models.TypeMaintenance, map[string]interface{}{
+			"window":          map[string]interface{}{"start": "2024-03-01T10:00:00Z", "end": "2024-03-01T12:00:00Z"},
+			"affectedSystems": []interface{}{"api", "api"},
+		}, []string{"attributes.affectedSystems"}},
+	}
+
+	schemas := make(map[models.EventType]json.RawMessage)
+	for _, eventType := range models.BuiltinEventTypes {
+		if len(eventType.AttributesSchema) > 0 {
+			if err := validateAttributesSchema(eventType.Code, eventType.AttributesSchema); err != nil {
+				t.Fatalf("el esquema predefinido de %s no es válido: %v", eventType.Code, err)
+			}
+			schemas[eventType.Code] = eventType.AttributesSchema
+		}
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.eventType)+" "+strings.Join(tt.fields, ","), func(t *testing.T) {
+			schema, err := compileAttributesSchema(tt.eventType, schemas[tt.eventType])
+			if err != nil {
+				t.Fatalf("no se pudo compilar el esquema de %s: %v", tt.eventType, err)
+			}
+
+			err = validateAttributes(tt.eventType, schema, tt.attributes)
+			if tt.fields == nil {
+				if err != nil {
+					t.Fatalf("validateAttributes devolvió %v", err)
+				}
+				return
+			}
+
+			assertErrorType(t, err, apierror.ValidationFail)
+			apiErr, _ := apierror.AsError(err)
+
+			fields := make([]string, 0, len(apiErr.Details))
+			for _, detail := range apiErr.Details {
+				fields = append(fields, detail.Field)
+			}
+			sort.Strings(fields)
+
+			if !reflect.DeepEqual(fields, tt.fields) {
+				t.Fatalf("campos con error = %v, se esperaba %v", fields, tt.fields)
+			}
+		})
+	}
+}
+
+func TestAttributesChanged(t *testing.T) {
+	tests := []struct {
+		name   string
+		before map[string]interface{}
+		after  map[string]interface{}
+		want   bool
+	}{
+		{"sin campos", nil, nil, false},
+		{"un objeto vacío equivale a sin campos", nil, map[string]interface{}{}, false},
+		{"mismo valor con distinto tipo numérico", map[string]interface{}{"threshold": int32(90)}, map[string]interface{}{"threshold": 90.0}, false},
+		{"mismos campos en otro orden", map[string]interface{}{"a": 1, "b": 2}, map[string]interface{}{"b": 2, "a": 1}, false},
+		{"campo añadido", map[string]interface{}{"a": 1}, map[string]interface{}{"a": 1, "b": 2}, true},
+		{"valor anidado distinto", map[string]interface{}{"window": map[string]interface{}{"start": "a"}}, map[string]interface{}{"window": map[string]interface{}{"start": "b"}}, true},
+		{"campos eliminados", map[string]interface{}{"a": 1}, nil, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := attributesChanged(tt.before, tt.after); got != tt.want {
+				t.Fatalf("attributesChanged = %v, se esperaba %v", got, tt.want)
+			}
+		})
+	}
+}
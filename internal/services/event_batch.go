@@ -0,0 +1,96 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+	"time"
+
+	"events-api/internal/apierror"
+	"events-api/internal/models"
+)
+
+// CreateEvents crea un lote de eventos. Cada evento se valida por separado y los válidos se
+// insertan aunque otros fallen. El resultado indica, para cada posición del lote, el ID del
+// evento creado o el error que impidió crearlo.
+func (s *eventService) CreateEvents(ctx context.Context, items []json.RawMessage) (models.BatchEventResponse, error) {
+	if len(items) == 0 {
+		return models.BatchEventResponse{}, apierror.NewError(apierror.ValidationFail, "el lote no contiene eventos")
+	}
+
+	if len(items) > s.cfg.MaxBatchSize {
+		return models.BatchEventResponse{}, apierror.NewError(apierror.ValidationFail, "el lote admite como máximo "+strconv.Itoa(s.cfg.MaxBatchSize)+" eventos")
+	}
+
+	policies, err := s.slaPolicies(ctx)
+	if err != nil {
+		return models.BatchEventResponse{}, err
+	}
+
+	now := time.Now()
+	results := make([]models.BatchEventResult, len(items))
+	events := make([]models.Event, 0, len(items))
+	indexes := make([]int, 0, len(items))
+
+	for i, item := range items {
+		results[i].Index = i
+
+		var req models.CreateEventRequest
+		if err := json.Unmarshal(item, &req); err != nil {
+			results[i].Error = toAPIError(apierror.NewError(apierror.BadRequest, "error en el formato de datos: "+err.Error()))
+			continue
+		}
+
+		eventType, err := s.validateCreateEventRequest(ctx, req, true, true)
+		if err != nil {
+			results[i].Error = toAPIError(err)
+			continue
+		}
+
+		event := newEvent(req, eventType)
+		setReviewDue(&event, policies[event.Type], now)
+
+		events = append(events, event)
+		indexes = append(indexes, i)
+	}
+
+	if len(events) > 0 {
+		errs, err := s.repository.BulkInsert(ctx, events)
+		if err != nil {
+			return models.BatchEventResponse{}, err
+		}
+
+		befores := make([]*models.Event, 0, len(events))
+		created := make([]*models.Event, 0, len(events))
+		for i := range events {
+			index := indexes[i]
+			if errs[i] != nil {
+				results[index].Error = toAPIError(errs[i])
+				continue
+			}
+
+			results[index].ID = events[i].ID.Hex()
+			results[index].Success = true
+			befores = append(befores, nil)
+			created = append(created, &events[i])
+		}
+
+		if len(created) > 0 {
+			s.recordHistoryMany(ctx, models.OperationCreate, befores, created)
+		}
+	}
+
+	response := models.BatchEventResponse{
+		Total:   len(results),
+		Results: results,
+	}
+	for _, result := range results {
+		if result.Success {
+			response.Created++
+		} else {
+			response.Failed++
+		}
+	}
+
+	return response, nil
+}
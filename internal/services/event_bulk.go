@@ -0,0 +1,298 @@
+package services
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"events-api/internal/apierror"
+	"events-api/internal/models"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// bulkHistoryOperations asocia cada acción masiva con la operación que se registra en el historial
+var bulkHistoryOperations = map[models.BulkActionType]models.HistoryOperation{
+	models.BulkReview:   models.OperationReview,
+	models.BulkUnreview: models.OperationUnreview,
+	models.BulkDelete:   models.OperationDelete,
+	models.BulkRetype:   models.OperationUpdate,
+}
+
+// bulkItem es una operación masiva validada y lista para guardarse
+type bulkItem struct {
+	index  int
+	before models.Event
+	after  models.Event
+	// rotationTeamID es el equipo cuyo turno de asignación usó la operación, si lo usó
+	rotationTeamID string
+}
+
+// BulkEvents aplica una operación masiva sobre varios eventos. Cada operación se valida con las
+// mismas reglas que su operación individual y todas se guardan con una sola escritura masiva.
+// El resultado indica, para cada operación, si se aplicó o el error que lo impidió.
+func (s *eventService) BulkEvents(ctx context.Context, req models.BulkEventRequest) (models.BulkEventResponse, error) {
+	operations, err := s.resolveBulkOperations(ctx, req)
+	if err != nil {
+		return models.BulkEventResponse{}, err
+	}
+
+	ids := make([]string, 0, len(operations))
+	for _, operation := range operations {
+		ids = append(ids, operation.ID)
+	}
+
+	events, err := s.repository.FindByIDs(ctx, ids)
+	if err != nil {
+		return models.BulkEventResponse{}, err
+	}
+
+	eventsByID := make(map[string]models.Event, len(events))
+	for _, event := range events {
+		eventsByID[event.ID.Hex()] = event
+	}
+
+	results := make([]models.BulkOperationResult, len(operations))
+	items := make([]bulkItem, 0, len(operations))
+	seen := make(map[string]bool, len(operations))
+	rotation := &models.AssignmentRotation{}
+	now := time.Now()
+
+	for i, operation := range operations {
+		results[i] = models.BulkOperationResult{
+			Index:  i,
+			ID:     operation.ID,
+			Action: operation.Action,
+		}
+
+		item, err := s.prepareBulkOperation(ctx, operation, eventsByID, seen, rotation, now)
+		if err != nil {
+			results[i].Error = toAPIError(err)
+			continue
+		}
+
+		item.index = i
+		items = append(items, item)
+	}
+
+	failed := len(operations) - len(items)
+	if req.Atomic && failed > 0 {
+		abortBulkItems(results, items, apierror.NewError(apierror.Aborted, "la operación no se aplicó porque otras operaciones de la solicitud fallaron"))
+		return newBulkEventResponse(req.Atomic, results), nil
+	}
+
+	if len(items) == 0 {
+		return newBulkEventResponse(req.Atomic, results), nil
+	}
+
+	updates := make([]models.Event, len(items))
+	for i, item := range items {
+		updates[i] = item.after
+	}
+
+	updated, errs, err := s.repository.BulkUpdate(ctx, updates, req.Atomic)
+	if err != nil {
+		// Si alguna actualización atómica no se aplicó, cada operación indica su error o que se
+		// abortó; el resto de errores, como un servidor sin transacciones, se devuelven como error
+		// de la solicitud
+		if apiErr, ok := apierror.AsError(err); ok && req.Atomic && apiErr.Type == apierror.Aborted {
+			abortBulkItems(results, items, apiErr)
+			for i, item := range items {
+				if i < len(errs) && errs[i] != nil {
+					results[item.index].Error = toAPIError(errs[i])
+				}
+			}
+			return newBulkEventResponse(req.Atomic, results), nil
+		}
+		return models.BulkEventResponse{}, err
+	}
+
+	entries := make([]models.EventHistoryEntry, 0, len(items))
+	usedTurns := make(map[string]int64)
+	var deletedIDs []primitive.ObjectID
+	for i, item := range items {
+		if errs[i] != nil {
+			results[item.index].Error = toAPIError(errs[i])
+			continue
+		}
+
+		results[item.index].Success = true
+		results[item.index].Version = updated[i].Version
+
+		operation := bulkHistoryOperations[operations[item.index].Action]
+		entries = append(entries, newHistoryEntry(ctx, operation, &items[i].before, &updated[i]))
+
+		if operations[item.index].Action == models.BulkDelete {
+			deletedIDs = append(deletedIDs, updated[i].ID)
+		}
+
+		if item.rotationTeamID != "" {
+			usedTurns[item.rotationTeamID]++
+		}
+	}
+
+	// Solo se consumen los turnos de asignación de las operaciones aplicadas
+	s.teamService.AdvanceRotation(ctx, usedTurns)
+
+	if len(entries) > 0 {
+		s.recordHistoryEntries(ctx, entries)
+	}
+
+	s.commentService.ArchiveEventComments(ctx, deletedIDs...)
+
+	return newBulkEventResponse(req.Atomic, results), nil
+}
+
+// resolveBulkOperations valida la solicitud y devuelve las operaciones a aplicar. Si la solicitud
+// indica un filtro, se genera una operación con la acción indicada por cada evento que lo cumple.
+func (s *eventService) resolveBulkOperations(ctx context.Context, req models.BulkEventRequest) ([]models.BulkOperation, error) {
+	if len(req.Operations) > 0 {
+		if req.Filter != nil || req.Action != "" {
+			return nil, apierror.NewError(apierror.ValidationFail, "indique una lista de operaciones o un filtro con una acción, pero no ambos")
+		}
+
+		if len(req.Operations) > models.MaxBulkOperations {
+			return nil, apierror.NewError(apierror.ValidationFail, "una operación masiva admite como máximo "+strconv.Itoa(models.MaxBulkOperations)+" eventos")
+		}
+
+		return req.Operations, nil
+	}
+
+	if req.Filter == nil {
+		return nil, apierror.NewError(apierror.ValidationFail, "indique una lista de operaciones o un filtro con una acción")
+	}
+
+	if req.Action == "" {
+		return nil, apierror.NewError(apierror.ValidationFail, "la acción es obligatoria al usar un filtro")
+	}
+
+	if err := s.validateEventFilter(ctx, *req.Filter); err != nil {
+		return nil, err
+	}
+
+	opts := models.ListOptions{Page: 1, PageSize: models.MaxBulkOperations}
+	events, total, err := s.repository.FindByFilter(ctx, *req.Filter, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	if total > models.MaxBulkOperations {
+		return nil, apierror.NewError(apierror.ValidationFail, "el filtro selecciona "+strconv.FormatInt(total, 10)+" eventos y una operación masiva admite como máximo "+strconv.Itoa(models.MaxBulkOperations))
+	}
+
+	if total == 0 {
+		return nil, apierror.NewError(apierror.NotFound, "no se encontraron eventos")
+	}
+
+	operations := make([]models.BulkOperation, 0, len(events))
+	for _, event := range events {
+		operations = append(operations, models.BulkOperation{
+			ID:         event.ID.Hex(),
+			BulkAction: req.BulkAction,
+		})
+	}
+
+	return operations, nil
+}
+
+// prepareBulkOperation valida una operación masiva y calcula el nuevo estado del evento. Los
+// turnos de asignación automática se reservan en rotation.
+func (s *eventService) prepareBulkOperation(ctx context.Context, operation models.BulkOperation, eventsByID map[string]models.Event, seen map[string]bool, rotation *models.AssignmentRotation, now time.Time) (bulkItem, error) {
+	if !primitive.IsValidObjectID(operation.ID) {
+		return bulkItem{}, apierror.NewError(apierror.BadRequest, "ID de evento inválido")
+	}
+
+	if seen[operation.ID] {
+		return bulkItem{}, apierror.NewError(apierror.ValidationFail, "el evento aparece en más de una operación")
+	}
+	seen[operation.ID] = true
+
+	event, ok := eventsByID[operation.ID]
+	if !ok {
+		return bulkItem{}, apierror.NewError(apierror.NotFound, "evento no encontrado")
+	}
+
+	if operation.Version != nil {
+		if err := checkVersion(event, *operation.Version); err != nil {
+			return bulkItem{}, err
+		}
+	}
+
+	item := bulkItem{before: event, after: event}
+
+	switch operation.Action {
+	case models.BulkReview:
+		req := models.ReviewEventRequest{
+			ManagementStatus: operation.ManagementStatus,
+			Justification:    operation.Justification,
+		}
+		if err := s.applyReview(ctx, &item.after, req, rotation); err != nil {
+			return bulkItem{}, err
+		}
+		if item.before.AssigneeID == "" && item.after.AssigneeID != "" {
+			item.rotationTeamID = item.after.TeamID
+		}
+	case models.BulkUnreview:
+		if err := applyUnreview(&item.after); err != nil {
+			return bulkItem{}, err
+		}
+		if err := s.recomputeSLA(ctx, &item.after); err != nil {
+			return bulkItem{}, err
+		}
+	case models.BulkDelete:
+		item.after.DeletedAt = &now
+	case models.BulkRetype:
+		if _, err := s.eventTypeService.ValidateEventType(ctx, operation.EventType, true); err != nil {
+			return bulkItem{}, err
+		}
+		// Los campos personalizados solo se validan con el esquema del tipo si el tipo cambia
+		if item.after.Type != operation.EventType {
+			if err := s.eventTypeService.ValidateAttributes(ctx, operation.EventType, item.after.Attributes); err != nil {
+				return bulkItem{}, err
+			}
+			item.after.Type = operation.EventType
+			if err := s.recomputeSLA(ctx, &item.after); err != nil {
+				return bulkItem{}, err
+			}
+		}
+	default:
+		return bulkItem{}, apierror.NewError(apierror.ValidationFail, "acción no válida: "+string(operation.Action))
+	}
+
+	return item, nil
+}
+
+// abortBulkItems marca como fallidas las operaciones válidas de una operación masiva atómica que no se aplicó
+func abortBulkItems(results []models.BulkOperationResult, items []bulkItem, apiErr apierror.Error) {
+	for _, item := range items {
+		results[item.index].Error = &apiErr
+	}
+}
+
+// newBulkEventResponse construye la respuesta de una operación masiva
+func newBulkEventResponse(atomic bool, results []models.BulkOperationResult) models.BulkEventResponse {
+	response := models.BulkEventResponse{
+		Atomic:  atomic,
+		Total:   len(results),
+		Results: results,
+	}
+
+	for _, result := range results {
+		if result.Success {
+			response.Succeeded++
+		} else {
+			response.Failed++
+		}
+	}
+
+	return response
+}
+
+// toAPIError convierte un error en un error de la API para incluirlo en una respuesta
+func toAPIError(err error) *apierror.Error {
+	apiErr, ok := apierror.AsError(err)
+	if !ok {
+		apiErr = apierror.NewError(apierror.Internal, err.Error())
+	}
+	return &apiErr
+}
@@ -0,0 +1,173 @@
+package services
+
+import (
+	"context"
+	"log"
+	"strings"
+	"time"
+
+	"events-api/internal/actor"
+	"events-api/internal/apierror"
+	"events-api/internal/models"
+)
+
+// EscalateEvents sube el nivel de escalado de los eventos sin atender cuyo tipo tiene una
+// política habilitada y registra cada escalado en el historial. Cada ejecución evalúa como máximo
+// EscalationBatchSize eventos, los que tienen un nivel vencido o no se han evaluado desde su
+// última modificación, y guarda en cada uno cuándo vence su siguiente nivel. Devuelve cuántos
+// eventos se han escalado.
+func (s *eventService) EscalateEvents(ctx context.Context) (int, error) {
+	policies, err := s.escalationService.GetEnabledPolicies(ctx)
+	if err != nil || len(policies) == 0 {
+		return 0, err
+	}
+
+	eventTypes := make([]models.EventType, 0, len(policies))
+	for eventType := range policies {
+		eventTypes = append(eventTypes, eventType)
+	}
+
+	now := time.Now()
+	events, err := s.repository.FindUnhandled(ctx, eventTypes, now, s.cfg.EscalationBatchSize)
+	if err != nil {
+		return 0, err
+	}
+
+	ctx = actor.WithID(ctx, actor.System)
+	escalated := 0
+
+	for i := range events {
+		event := events[i]
+		policy := policies[event.Type]
+
+		escalation, ok := nextEscalation(event, policy, now)
+		if !ok {
+			// El evento no se vuelve a evaluar hasta que venza su siguiente nivel
+			if err := s.repository.SetEscalationDue(ctx, event, escalationDueAt(event, policy)); err != nil {
+				return escalated, err
+			}
+			continue
+		}
+
+		escalatedEvent := event
+		escalatedEvent.Escalation = &escalation
+
+		updatedEvent, applied, err := s.repository.Escalate(ctx, event, escalation, escalationDueAt(escalatedEvent, policy))
+		if err != nil {
+			return escalated, err
+		}
+
+		// El evento se ha atendido o escalado desde que se leyó
+		if !applied {
+			continue
+		}
+
+		log.Printf("Evento %s escalado al nivel %d: %s\n", event.ID.Hex(), escalation.Level, strings.Join(escalation.Targets, ", "))
+		s.recordHistory(ctx, models.OperationEscalate, &event, &updatedEvent)
+		escalated++
+	}
+
+	return escalated, nil
+}
+
+// AcknowledgeEscalation confirma la recepción del escalado de un evento, lo que detiene la
+// cadena de escalado hasta que el evento se reabra
+func (s *eventService) AcknowledgeEscalation(ctx context.Context, id string, version int64) (models.EventResponse, error) {
+	existingEvent, err := s.repository.FindByID(ctx, id)
+	if err != nil {
+		return models.EventResponse{}, err
+	}
+
+	if err := checkVersion(existingEvent, version); err != nil {
+		return models.EventResponse{}, err
+	}
+
+	if !isEscalationActive(existingEvent) {
+		return models.EventResponse{}, apierror.NewError(apierror.ValidationFail, "el evento no tiene un escalado pendiente de confirmar")
+	}
+
+	fields := map[string]interface{}{
+		"escalation.acknowledged_by": actor.FromContext(ctx),
+		"escalation.acknowledged_at": time.Now(),
+	}
+
+	updatedEvent, err := s.repository.Patch(ctx, id, existingEvent.Version, fields)
+	if err != nil {
+		return models.EventResponse{}, err
+	}
+
+	s.recordHistory(ctx, models.OperationAcknowledge, &existingEvent, &updatedEvent)
+
+	return mapEventToResponse(updatedEvent), nil
+}
+
+// nextEscalation calcula el escalado que corresponde a un evento sin atender en el instante
+// indicado. Si el escalado del evento se calculó antes de su última reapertura, la cadena
+// comienza de nuevo. Si se ha superado más de un nivel desde la última comprobación, el evento
+// pasa directamente al nivel más alto alcanzado.
+func nextEscalation(event models.Event, policy models.EscalationPolicy, now time.Time) (models.EventEscalation, bool) {
+	startedAt := escalationStart(event)
+
+	level := 0
+	if current := event.Escalation; current != nil && !current.StartedAt.Before(startedAt) {
+		if current.AcknowledgedAt != nil {
+			return models.EventEscalation{}, false
+		}
+		level = current.Level
+	}
+
+	next := level
+	for next < len(policy.Levels) && now.Sub(startedAt) >= policy.Levels[next].Delay() {
+		next++
+	}
+
+	if next == level {
+		return models.EventEscalation{}, false
+	}
+
+	return models.EventEscalation{
+		PolicyID:    policy.ID.Hex(),
+		Level:       next,
+		Targets:     policy.Levels[next-1].Targets,
+		StartedAt:   startedAt,
+		EscalatedAt: now,
+	}, true
+}
+
+// escalationDueAt devuelve el instante en que vence el siguiente nivel de escalado de un evento
+// sin atender, o nil si su cadena de escalado ha terminado o se ha confirmado
+func escalationDueAt(event models.Event, policy models.EscalationPolicy) *time.Time {
+	startedAt := escalationStart(event)
+
+	level := 0
+	if current := event.Escalation; current != nil && !current.StartedAt.Before(startedAt) {
+		if current.AcknowledgedAt != nil {
+			return nil
+		}
+		level = current.Level
+	}
+
+	if level >= len(policy.Levels) {
+		return nil
+	}
+
+	dueAt := startedAt.Add(policy.Levels[level].Delay())
+	return &dueAt
+}
+
+// escalationStart devuelve el inicio del periodo sin atender de un evento: su reapertura o, si
+// no se ha reabierto, su creación
+func escalationStart(event models.Event) time.Time {
+	if event.ReopenedAt != nil && event.ReopenedAt.After(event.CreatedAt) {
+		return *event.ReopenedAt
+	}
+	return event.CreatedAt
+}
+
+// isEscalationActive indica si el evento tiene un escalado vigente sin confirmar
+func isEscalationActive(event models.Event) bool {
+	escalation := event.Escalation
+	return escalation != nil &&
+		escalation.AcknowledgedAt == nil &&
+		!escalation.StartedAt.Before(escalationStart(event))
+}
@@ -0,0 +1,161 @@
+package services
+
+import (
+	"context"
+	"log"
+	"reflect"
+	"sort"
+	"time"
+
+	"events-api/internal/actor"
+	"events-api/internal/models"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// historyIgnoredFields son los campos que no se registran en los cambios del historial
+var historyIgnoredFields = map[string]bool{
+	"_id":               true,
+	"updated_at":        true,
+	"score":             true,
+	"escalation_due_at": true,
+}
+
+const (
+	// historyWriteTimeout es el tiempo máximo para registrar una operación en el historial
+	historyWriteTimeout = 5 * time.Second
+	// historyWriteAttempts es el número de intentos para registrar una operación en el historial
+	historyWriteAttempts = 3
+	// historyRetryDelay es la espera antes del primer reintento; se duplica en cada reintento
+	historyRetryDelay = 100 * time.Millisecond
+)
+
+// recordHistory registra una operación sobre un evento en el historial. before es nil en las
+// creaciones y after es nil en los borrados.
+func (s *eventService) recordHistory(ctx context.Context, operation models.HistoryOperation, before, after *models.Event) {
+	s.recordHistoryEntries(ctx, []models.EventHistoryEntry{newHistoryEntry(ctx, operation, before, after)})
+}
+
+// recordHistoryMany registra en el historial una misma operación sobre varios eventos
+func (s *eventService) recordHistoryMany(ctx context.Context, operation models.HistoryOperation, befores, afters []*models.Event) {
+	entries := make([]models.EventHistoryEntry, 0, len(afters))
+	for i := range afters {
+		entries = append(entries, newHistoryEntry(ctx, operation, befores[i], afters[i]))
+	}
+
+	s.recordHistoryEntries(ctx, entries)
+}
+
+// recordHistoryEntries registra en el historial varias entradas ya construidas. La operación ya
+// se ha aplicado, por lo que el registro usa un contexto propio que no se cancela con la
+// solicitud y se reintenta si falla; si se agotan los intentos, el fallo no revierte la
+// operación y se deja constancia en el log con las entradas perdidas.
+func (s *eventService) recordHistoryEntries(ctx context.Context, entries []models.EventHistoryEntry) {
+	if len(entries) == 0 {
+		return
+	}
+
+	writeCtx, cancel := context.WithTimeout(context.Background(), historyWriteTimeout)
+	defer cancel()
+
+	delay := historyRetryDelay
+	var err error
+retry:
+	for attempt := 1; attempt <= historyWriteAttempts; attempt++ {
+		// Las entradas tienen su ID asignado, por lo que un reintento no las duplica
+		if err = s.historyRepository.CreateMany(writeCtx, entries); err == nil {
+			return
+		}
+
+		if attempt < historyWriteAttempts {
+			select {
+			case <-writeCtx.Done():
+				break retry
+			case <-time.After(delay):
+				delay *= 2
+			}
+		}
+	}
+
+	for _, entry := range entries {
+		log.Printf("Error al registrar en el historial la operación %s del evento %s por %s: %v\n", entry.Operation, entry.EventID.Hex(), entry.Actor, err)
+	}
+}
+
+// newHistoryEntry construye una entrada del historial con el actor del contexto
+func newHistoryEntry(ctx context.Context, operation models.HistoryOperation, before, after *models.Event) models.EventHistoryEntry {
+	entry := models.EventHistoryEntry{
+		ID:        primitive.NewObjectID(),
+		Actor:     actor.FromContext(ctx),
+		Operation: operation,
+		Timestamp: time.Now(),
+		Changes:   diffEvents(before, after),
+	}
+
+	if after != nil {
+		entry.EventID = after.ID
+	} else if before != nil {
+		entry.EventID = before.ID
+	}
+
+	return entry
+}
+
+// diffEvents calcula los cambios campo a campo entre dos versiones de un evento, usando los
+// nombres de campo con los que se persisten. Un evento nil se trata como inexistente.
+func diffEvents(before, after *models.Event) []models.FieldChange {
+	beforeFields := eventFields(before)
+	afterFields := eventFields(after)
+
+	names := make(map[string]bool)
+	for name := range beforeFields {
+		names[name] = true
+	}
+	for name := range afterFields {
+		names[name] = true
+	}
+
+	fields := make([]string, 0, len(names))
+	for name := range names {
+		if !historyIgnoredFields[name] {
+			fields = append(fields, name)
+		}
+	}
+	sort.Strings(fields)
+
+	changes := make([]models.FieldChange, 0)
+	for _, field := range fields {
+		beforeValue, afterValue := beforeFields[field], afterFields[field]
+		if reflect.DeepEqual(beforeValue, afterValue) {
+			continue
+		}
+
+		changes = append(changes, models.FieldChange{
+			Field:  field,
+			Before: beforeValue,
+			After:  afterValue,
+		})
+	}
+
+	return changes
+}
+
+// eventFields devuelve los campos persistidos de un evento
+func eventFields(event *models.Event) bson.M {
+	fields := bson.M{}
+	if event == nil {
+		return fields
+	}
+
+	data, err := bson.Marshal(event)
+	if err != nil {
+		return fields
+	}
+
+	if err := bson.Unmarshal(data, &fields); err != nil {
+		return bson.M{}
+	}
+
+	return fields
+}
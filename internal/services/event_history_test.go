@@ -0,0 +1,63 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"events-api/internal/models"
+	"events-api/internal/repositories"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// fakeHistoryRepository falla las primeras inserciones y registra los contextos recibidos
+type fakeHistoryRepository struct {
+	repositories.HistoryRepository
+	failures int
+	calls    int
+	ctxErrs  []error
+}
+
+func (r *fakeHistoryRepository) CreateMany(ctx context.Context, entries []models.EventHistoryEntry) error {
+	r.calls++
+	r.ctxErrs = append(r.ctxErrs, ctx.Err())
+	if r.calls <= r.failures {
+		return errors.New("error de prueba")
+	}
+	return nil
+}
+
+func TestRecordHistoryEntries(t *testing.T) {
+	tests := []struct {
+		name      string
+		failures  int
+		wantCalls int
+	}{
+		{"se registra al primer intento", 0, 1},
+		{"se reintenta tras un fallo", 1, 2},
+		{"se agotan los intentos", historyWriteAttempts, historyWriteAttempts},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &fakeHistoryRepository{failures: tt.failures}
+			service := &eventService{historyRepository: repo}
+
+			// La solicitud ya terminó: el registro no debe usar su contexto
+			ctx, cancel := context.WithCancel(context.Background())
+			cancel()
+
+			service.recordHistoryEntries(ctx, []models.EventHistoryEntry{{ID: primitive.NewObjectID(), EventID: primitive.NewObjectID()}})
+
+			if repo.calls != tt.wantCalls {
+				t.Fatalf("intentos = %d, se esperaban %d", repo.calls, tt.wantCalls)
+			}
+			for i, err := range repo.ctxErrs {
+				if err != nil {
+					t.Errorf("el intento %d recibió un contexto cancelado: %v", i+1, err)
+				}
+			}
+		})
+	}
+}
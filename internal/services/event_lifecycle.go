@@ -0,0 +1,99 @@
+package services
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"events-api/internal/apierror"
+	"events-api/internal/models"
+)
+
+// TransitionEvent cambia el estado del ciclo de vida de un evento en la versión indicada,
+// registrando la nota y la fecha de la transición
+func (s *eventService) TransitionEvent(ctx context.Context, id string, version int64, status models.EventStatus, req models.TransitionEventRequest) (models.EventResponse, error) {
+	existingEvent, err := s.repository.FindByID(ctx, id)
+	if err != nil {
+		return models.EventResponse{}, err
+	}
+
+	if err := checkVersion(existingEvent, version); err != nil {
+		return models.EventResponse{}, err
+	}
+	before := existingEvent
+
+	if err := applyTransition(&existingEvent, status, req.Note, time.Now()); err != nil {
+		return models.EventResponse{}, err
+	}
+
+	// Un evento reabierto tiene un nuevo plazo de resolución
+	if status == models.StatusReopened {
+		if err := s.recomputeSLA(ctx, &existingEvent); err != nil {
+			return models.EventResponse{}, err
+		}
+	}
+
+	updatedEvent, err := s.repository.Update(ctx, id, existingEvent)
+	if err != nil {
+		return models.EventResponse{}, err
+	}
+
+	s.recordHistory(ctx, models.OperationTransition, &before, &updatedEvent)
+
+	return mapEventToResponse(updatedEvent), nil
+}
+
+// GetEventStatus devuelve los estados del ciclo de vida con los estados a los que puede pasar cada uno
+func (s *eventService) GetEventStatus(ctx context.Context) []models.EventStatusInfo {
+	statuses := make([]models.EventStatusInfo, 0, len(models.EventStatuses))
+	for _, status := range models.EventStatuses {
+		statuses = append(statuses, models.EventStatusInfo{
+			Status: status,
+			Next:   models.EventTransitions[status],
+		})
+	}
+	return statuses
+}
+
+// applyTransition cambia el estado de un evento a uno de los estados de resolución. La revisión
+// y su reversión tienen sus propias operaciones, ya que además cambian el estado de gestión.
+func applyTransition(event *models.Event, status models.EventStatus, note string, now time.Time) error {
+	if err := checkTransition(event.Status, status); err != nil {
+		return err
+	}
+
+	note = strings.TrimSpace(note)
+
+	switch status {
+	case models.StatusInProgress:
+		event.StartedAt = &now
+	case models.StatusResolved:
+		if note == "" {
+			return apierror.NewError(apierror.ValidationFail, "la nota de resolución es obligatoria al resolver el evento")
+		}
+		event.ResolutionNote = note
+		event.ResolvedAt = &now
+	case models.StatusClosed:
+		event.ClosedAt = &now
+	case models.StatusReopened:
+		// La resolución anterior deja de estar vigente; queda registrada en el historial
+		event.ReopenedAt = &now
+		event.ResolvedAt = nil
+		event.ClosedAt = nil
+		event.ResolutionNote = ""
+	default:
+		return apierror.NewError(apierror.ValidationFail, "estado de destino no válido: "+string(status))
+	}
+
+	event.Status = status
+	event.StatusNote = note
+	return nil
+}
+
+// checkTransition verifica que la tabla de transiciones permita pasar del estado from al estado to
+func checkTransition(from, to models.EventStatus) error {
+	if !models.CanTransition(from, to) {
+		return apierror.NewError(apierror.ValidationFail, "transición de estado no permitida: "+string(from)+" -> "+string(to))
+	}
+	return nil
+}
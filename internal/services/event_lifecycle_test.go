@@ -0,0 +1,204 @@
+package services
+
+import (
+	"testing"
+	"time"
+
+	"events-api/internal/apierror"
+	"events-api/internal/models"
+)
+
+func TestCheckTransition(t *testing.T) {
+	tests := []struct {
+		name    string
+		from    models.EventStatus
+		to      models.EventStatus
+		allowed bool
+	}{
+		{"pendiente a revisado", models.StatusPending, models.StatusReviewed, true},
+		{"pendiente a resuelto", models.StatusPending, models.StatusResolved, false},
+		{"revisado a revisado para reclasificar", models.StatusReviewed, models.StatusReviewed, true},
+		{"revisado a pendiente al deshacer la revisión", models.StatusReviewed, models.StatusPending, true},
+		{"revisado a en curso", models.StatusReviewed, models.StatusInProgress, true},
+		{"revisado a cerrado", models.StatusReviewed, models.StatusClosed, true},
+		{"en curso a resuelto", models.StatusInProgress, models.StatusResolved, true},
+		{"en curso a cerrado", models.StatusInProgress, models.StatusClosed, false},
+		{"resuelto a reabierto", models.StatusResolved, models.StatusReopened, true},
+		{"resuelto a en curso", models.StatusResolved, models.StatusInProgress, false},
+		{"cerrado a reabierto", models.StatusClosed, models.StatusReopened, true},
+		{"cerrado a resuelto", models.StatusClosed, models.StatusResolved, false},
+		{"reabierto a en curso", models.StatusReopened, models.StatusInProgress, true},
+		{"reabierto a pendiente", models.StatusReopened, models.StatusPending, false},
+		{"estado desconocido", models.EventStatus("UNKNOWN"), models.StatusReviewed, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := models.CanTransition(tt.from, tt.to); got != tt.allowed {
+				t.Fatalf("CanTransition(%s, %s) = %v, se esperaba %v", tt.from, tt.to, got, tt.allowed)
+			}
+
+			err := checkTransition(tt.from, tt.to)
+			if tt.allowed {
+				if err != nil {
+					t.Fatalf("checkTransition(%s, %s) devolvió %v", tt.from, tt.to, err)
+				}
+				return
+			}
+
+			assertErrorType(t, err, apierror.ValidationFail)
+		})
+	}
+}
+
+func TestEventTransitionsCoverAllStatuses(t *testing.T) {
+	known := make(map[models.EventStatus]bool, len(models.EventStatuses))
+	for _, status := range models.EventStatuses {
+		known[status] = true
+	}
+
+	for _, status := range models.EventStatuses {
+		if _, ok := models.EventTransitions[status]; !ok {
+			t.Errorf("el estado %s no tiene transiciones", status)
+		}
+	}
+
+	for from, next := range models.EventTransitions {
+		if !known[from] {
+			t.Errorf("la tabla incluye el estado desconocido %s", from)
+		}
+		for _, to := range next {
+			if !known[to] {
+				t.Errorf("la transición %s -> %s lleva a un estado desconocido", from, to)
+			}
+		}
+	}
+}
+
+func TestApplyTransition(t *testing.T) {
+	now := time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC)
+	earlier := now.Add(-time.Hour)
+
+	tests := []struct {
+		name    string
+		event   models.Event
+		status  models.EventStatus
+		note    string
+		errType apierror.Type
+		check   func(t *testing.T, event models.Event)
+	}{
+		{
+			name:   "empezar un evento revisado",
+			event:  models.Event{Status: models.StatusReviewed},
+			status: models.StatusInProgress,
+			note:   "  en ello  ",
+			check: func(t *testing.T, event models.Event) {
+				if event.StartedAt == nil || !event.StartedAt.Equal(now) {
+					t.Errorf("startedAt = %v, se esperaba %v", event.StartedAt, now)
+				}
+				if event.StatusNote != "en ello" {
+					t.Errorf("statusNote = %q, se esperaba la nota sin espacios", event.StatusNote)
+				}
+			},
+		},
+		{
+			name:   "resolver con nota",
+			event:  models.Event{Status: models.StatusInProgress},
+			status: models.StatusResolved,
+			note:   "Se reinició el servicio",
+			check: func(t *testing.T, event models.Event) {
+				if event.ResolutionNote != "Se reinició el servicio" {
+					t.Errorf("resolutionNote = %q", event.ResolutionNote)
+				}
+				if event.ResolvedAt == nil || !event.ResolvedAt.Equal(now) {
+					t.Errorf("resolvedAt = %v, se esperaba %v", event.ResolvedAt, now)
+				}
+			},
+		},
+		{
+			name:    "resolver sin nota",
+			event:   models.Event{Status: models.StatusInProgress},
+			status:  models.StatusResolved,
+			note:    "   ",
+			errType: apierror.ValidationFail,
+		},
+		{
+			name:   "cerrar un evento resuelto",
+			event:  models.Event{Status: models.StatusResolved},
+			status: models.StatusClosed,
+			check: func(t *testing.T, event models.Event) {
+				if event.ClosedAt == nil || !event.ClosedAt.Equal(now) {
+					t.Errorf("closedAt = %v, se esperaba %v", event.ClosedAt, now)
+				}
+			},
+		},
+		{
+			name: "reabrir descarta la resolución y el cierre",
+			event: models.Event{
+				Status:         models.StatusClosed,
+				ResolutionNote: "Resuelto",
+				ResolvedAt:     &earlier,
+				ClosedAt:       &earlier,
+			},
+			status: models.StatusReopened,
+			check: func(t *testing.T, event models.Event) {
+				if event.ReopenedAt == nil || !event.ReopenedAt.Equal(now) {
+					t.Errorf("reopenedAt = %v, se esperaba %v", event.ReopenedAt, now)
+				}
+				if event.ResolvedAt != nil || event.ClosedAt != nil || event.ResolutionNote != "" {
+					t.Errorf("la resolución anterior sigue vigente: %+v", event)
+				}
+			},
+		},
+		{
+			name:    "transición no permitida",
+			event:   models.Event{Status: models.StatusPending},
+			status:  models.StatusClosed,
+			errType: apierror.ValidationFail,
+		},
+		{
+			name:    "la revisión tiene su propia operación",
+			event:   models.Event{Status: models.StatusReopened},
+			status:  models.StatusReviewed,
+			errType: apierror.ValidationFail,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			event := tt.event
+			err := applyTransition(&event, tt.status, tt.note, now)
+
+			if tt.errType != "" {
+				assertErrorType(t, err, tt.errType)
+				if event.Status != tt.event.Status {
+					t.Errorf("el estado cambió a %s pese al error", event.Status)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("applyTransition devolvió %v", err)
+			}
+			if event.Status != tt.status {
+				t.Errorf("status = %s, se esperaba %s", event.Status, tt.status)
+			}
+			if tt.check != nil {
+				tt.check(t, event)
+			}
+		})
+	}
+}
+
+// assertErrorType verifica que err sea un error de la API del tipo indicado
+func assertErrorType(t *testing.T, err error, errType apierror.Type) {
+	t.Helper()
+
+	apiErr, ok := apierror.AsError(err)
+	if !ok {
+		t.Fatalf("se esperaba un error %s y se obtuvo %v", errType, err)
+	}
+	if apiErr.Type != errType {
+		t.Fatalf("tipo de error = %s, se esperaba %s (%s)", apiErr.Type, errType, apiErr.Message)
+	}
+}
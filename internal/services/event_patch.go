@@ -0,0 +1,132 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+
+	"events-api/internal/apierror"
+	"events-api/internal/models"
+
+	jsonpatch "github.com/evanphx/json-patch/v5"
+)
+
+// PatchEvent aplica una actualización parcial a un evento. El parche se aplica sobre los campos
+// editables del evento, el resultado se valida con las mismas reglas que la creación y solo se
+// persisten los campos que han cambiado.
+func (s *eventService) PatchEvent(ctx context.Context, id string, version int64, contentType string, patch []byte) (models.EventResponse, error) {
+	existingEvent, err := s.repository.FindByID(ctx, id)
+	if err != nil {
+		return models.EventResponse{}, err
+	}
+
+	if err := checkVersion(existingEvent, version); err != nil {
+		return models.EventResponse{}, err
+	}
+
+	req, err := applyEventPatch(existingEvent, contentType, patch)
+	if err != nil {
+		return models.EventResponse{}, err
+	}
+
+	// Un evento conserva su tipo aunque se haya desactivado, pero no puede cambiar a un tipo
+	// inactivo. Los campos personalizados solo se validan si cambian ellos o el tipo.
+	typeChanged := req.Type != existingEvent.Type
+	eventType, err := s.validateCreateEventRequest(ctx, req, typeChanged, typeChanged || attributesChanged(existingEvent.Attributes, req.Attributes))
+	if err != nil {
+		return models.EventResponse{}, err
+	}
+
+	patchedEvent := existingEvent
+	patchedEvent.Name = req.Name
+	patchedEvent.Type = req.Type
+	patchedEvent.Description = req.Description
+	patchedEvent.Date = req.Date
+	patchedEvent.Priority = req.Priority
+	patchedEvent.Severity = 0
+	if req.Severity != nil {
+		patchedEvent.Severity = *req.Severity
+	}
+	patchedEvent.Tags = normalizeTags(req.Tags)
+	patchedEvent.Labels = normalizeLabels(req.Labels)
+	patchedEvent.Attributes = normalizeAttributes(req.Attributes)
+
+	// Si el parche elimina la prioridad o la severidad, se derivan de nuevo del tipo
+	applyDefaultPriority(&patchedEvent, eventType)
+
+	// Los plazos se calculan de nuevo con la política del nuevo tipo
+	if patchedEvent.Type != existingEvent.Type {
+		if err := s.recomputeSLA(ctx, &patchedEvent); err != nil {
+			return models.EventResponse{}, err
+		}
+	}
+
+	changes := diffEvents(&existingEvent, &patchedEvent)
+	if len(changes) == 0 {
+		return mapEventToResponse(existingEvent), nil
+	}
+
+	fields := make(map[string]interface{}, len(changes))
+	for _, change := range changes {
+		fields[change.Field] = change.After
+	}
+
+	updatedEvent, err := s.repository.Patch(ctx, id, existingEvent.Version, fields)
+	if err != nil {
+		return models.EventResponse{}, err
+	}
+
+	s.recordHistory(ctx, models.OperationUpdate, &existingEvent, &updatedEvent)
+
+	return mapEventToResponse(updatedEvent), nil
+}
+
+// applyEventPatch aplica el parche sobre el documento JSON con los campos editables del evento
+// y decodifica el resultado. Los campos que no son editables se rechazan.
+func applyEventPatch(event models.Event, contentType string, patch []byte) (models.CreateEventRequest, error) {
+	current := models.CreateEventRequest{
+		Name:        event.Name,
+		Type:        event.Type,
+		Description: event.Description,
+		Date:        event.Date,
+		Priority:    event.Priority,
+		Tags:        event.Tags,
+		Labels:      event.Labels,
+		Attributes:  event.Attributes,
+	}
+	if event.Severity != 0 {
+		current.Severity = &event.Severity
+	}
+
+	document, err := json.Marshal(current)
+	if err != nil {
+		return models.CreateEventRequest{}, apierror.NewError(apierror.Internal, "error al preparar el evento: "+err.Error())
+	}
+
+	var patched []byte
+	switch contentType {
+	case models.MergePatchContentType:
+		patched, err = jsonpatch.MergePatch(document, patch)
+	case models.JSONPatchContentType:
+		var operations jsonpatch.Patch
+		operations, err = jsonpatch.DecodePatch(patch)
+		if err == nil {
+			patched, err = operations.Apply(document)
+		}
+	default:
+		return models.CreateEventRequest{}, apierror.NewError(apierror.UnsupportedMediaType,
+			"tipo de contenido no soportado, use "+models.MergePatchContentType+" o "+models.JSONPatchContentType)
+	}
+	if err != nil {
+		return models.CreateEventRequest{}, apierror.NewError(apierror.BadRequest, "no se pudo aplicar el parche: "+err.Error())
+	}
+
+	var req models.CreateEventRequest
+	decoder := json.NewDecoder(bytes.NewReader(patched))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&req); err != nil {
+		return models.CreateEventRequest{}, apierror.NewError(apierror.ValidationFail, "el evento resultante no es válido: "+err.Error())
+	}
+
+	return req, nil
+}
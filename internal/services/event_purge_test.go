@@ -0,0 +1,122 @@
+package services
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"events-api/internal/config"
+	"events-api/internal/models"
+	"events-api/internal/repositories"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// fakePurgeRepository simula una papelera con eventos que superan la retención
+type fakePurgeRepository struct {
+	repositories.EventRepository
+	trash []models.Event
+	// kept son los eventos que PurgeDeleted no elimina, como si se hubieran restaurado
+	kept    map[primitive.ObjectID]bool
+	batches []int
+}
+
+func (r *fakePurgeRepository) FindPurgeable(ctx context.Context, deletedBefore time.Time, limit int) ([]models.Event, error) {
+	events := r.trash
+	if len(events) > limit {
+		events = events[:limit]
+	}
+	r.batches = append(r.batches, len(events))
+	return append([]models.Event(nil), events...), nil
+}
+
+func (r *fakePurgeRepository) PurgeDeleted(ctx context.Context, ids []primitive.ObjectID, deletedBefore time.Time) ([]primitive.ObjectID, error) {
+	requested := make(map[primitive.ObjectID]bool, len(ids))
+	for _, id := range ids {
+		requested[id] = true
+	}
+
+	var purged []primitive.ObjectID
+	remaining := r.trash[:0]
+	for _, event := range r.trash {
+		if requested[event.ID] && !r.kept[event.ID] {
+			purged = append(purged, event.ID)
+			continue
+		}
+		remaining = append(remaining, event)
+	}
+	r.trash = remaining
+	return purged, nil
+}
+
+// fakePurgeComments registra los eventos cuyos comentarios se purgan
+type fakePurgeComments struct {
+	CommentService
+	purged []primitive.ObjectID
+}
+
+func (c *fakePurgeComments) PurgeEventComments(ctx context.Context, eventIDs ...primitive.ObjectID) (int64, error) {
+	c.purged = append(c.purged, eventIDs...)
+	return int64(len(eventIDs)), nil
+}
+
+func TestPurgeDeletedEvents(t *testing.T) {
+	tests := []struct {
+		name        string
+		events      int
+		kept        int
+		wantPurged  int64
+		wantBatches []int
+	}{
+		{"papelera vacía", 0, 0, 0, []int{0}},
+		{"un lote incompleto", 2, 0, 2, []int{2}},
+		{"varios lotes", 7, 0, 7, []int{3, 3, 1}},
+		{"lotes completos", 6, 0, 6, []int{3, 3, 0}},
+		{"un lote sin eventos eliminados detiene la purga", 7, 3, 0, []int{3}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &fakePurgeRepository{kept: make(map[primitive.ObjectID]bool)}
+			for i := 0; i < tt.events; i++ {
+				event := models.Event{ID: primitive.NewObjectID()}
+				if i < tt.kept {
+					repo.kept[event.ID] = true
+				}
+				repo.trash = append(repo.trash, event)
+			}
+
+			comments := &fakePurgeComments{}
+			service := &eventService{
+				repository:        repo,
+				historyRepository: &fakeHistoryRepository{},
+				commentService:    comments,
+				cfg:               &config.Config{PurgeBatchSize: 3},
+			}
+
+			purged, err := service.PurgeDeletedEvents(context.Background(), time.Hour)
+			if err != nil {
+				t.Fatalf("PurgeDeletedEvents devolvió %v", err)
+			}
+			if purged != tt.wantPurged {
+				t.Errorf("eventos purgados = %d, se esperaban %d", purged, tt.wantPurged)
+			}
+			if int64(len(comments.purged)) != tt.wantPurged {
+				t.Errorf("eventos con comentarios purgados = %d, se esperaban %d", len(comments.purged), tt.wantPurged)
+			}
+			for _, id := range comments.purged {
+				if repo.kept[id] {
+					t.Errorf("se purgaron los comentarios del evento %s, que sigue en la papelera", id.Hex())
+				}
+			}
+			if len(repo.batches) != len(tt.wantBatches) {
+				t.Fatalf("lotes = %v, se esperaban %v", repo.batches, tt.wantBatches)
+			}
+			for i := range repo.batches {
+				if repo.batches[i] != tt.wantBatches[i] {
+					t.Fatalf("lotes = %v, se esperaban %v", repo.batches, tt.wantBatches)
+				}
+			}
+		})
+	}
+}
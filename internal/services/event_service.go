@@ -2,26 +2,59 @@ package services
 
 import (
 	"context"
+	"encoding/json"
+	"io"
+	"log"
+	"mime/multipart"
+	"strings"
 	"time"
 
 	"events-api/internal/apierror"
+	"events-api/internal/config"
 	"events-api/internal/models"
 	"events-api/internal/repositories"
+	"events-api/internal/storage"
 
+	"github.com/gin-gonic/gin/binding"
 	"go.mongodb.org/mongo-driver/bson/primitive"
 )
 
 // EventService define las operaciones del servicio de eventos
 type EventService interface {
-	GetAllEvents(ctx context.Context) ([]models.EventResponse, error)
+	GetAllEvents(ctx context.Context, filter models.EventFilter, opts models.ListOptions) (models.EventListResponse, error)
+	SearchEvents(ctx context.Context, text string, filter models.EventFilter, opts models.ListOptions) (models.EventListResponse, error)
+	GetTriageQueue(ctx context.Context, opts models.ListOptions) (models.EventListResponse, error)
+	GetOverdueEvents(ctx context.Context, opts models.ListOptions) (models.EventListResponse, error)
+	GetEventStats(ctx context.Context, filter models.EventFilter, opts models.EventStatsOptions) (models.EventStats, error)
 	GetEventByID(ctx context.Context, id string) (models.EventResponse, error)
+	GetEventHistory(ctx context.Context, id string, opts models.ListOptions) (models.EventHistoryListResponse, error)
+	GetEventTags(ctx context.Context, prefix string, limit int) ([]models.TagCount, error)
+	AddEventTags(ctx context.Context, id string, version int64, req models.TagEventRequest) (models.EventResponse, error)
+	RemoveEventTag(ctx context.Context, id string, version int64, tag string) (models.EventResponse, error)
+	GetAttachments(ctx context.Context, id string) ([]models.Attachment, error)
+	GetAttachment(ctx context.Context, id string, attachmentID string) (models.Attachment, io.ReadSeekCloser, error)
+	UploadAttachment(ctx context.Context, id string, file *multipart.FileHeader) (models.Attachment, error)
+	DeleteAttachment(ctx context.Context, id string, attachmentID string) error
 	CreateEvent(ctx context.Context, req models.CreateEventRequest) (models.EventResponse, error)
-	UpdateEvent(ctx context.Context, id string, req models.UpdateEventRequest) (models.EventResponse, error)
-	DeleteEvent(ctx context.Context, id string) error
-	ReviewEvent(ctx context.Context, id string, req models.ReviewEventRequest) (models.EventResponse, error)
-	UnreviewEvent(ctx context.Context, id string) (models.EventResponse, error)
-	GetEventTypes(ctx context.Context) []string
-	GetEventStatus(ctx context.Context) []string
+	CreateEvents(ctx context.Context, items []json.RawMessage) (models.BatchEventResponse, error)
+	UpdateEvent(ctx context.Context, id string, version int64, req models.UpdateEventRequest) (models.EventResponse, error)
+	PatchEvent(ctx context.Context, id string, version int64, contentType string, patch []byte) (models.EventResponse, error)
+	DeleteEvent(ctx context.Context, id string, version int64) error
+	GetDeletedEvents(ctx context.Context, opts models.ListOptions) (models.EventListResponse, error)
+	RestoreEvent(ctx context.Context, id string) (models.EventResponse, error)
+	PurgeDeletedEvents(ctx context.Context, retention time.Duration) (int64, error)
+	FlagSLABreaches(ctx context.Context) (int64, error)
+	ReviewEvent(ctx context.Context, id string, version int64, req models.ReviewEventRequest) (models.EventResponse, error)
+	UnreviewEvent(ctx context.Context, id string, version int64) (models.EventResponse, error)
+	AssignEvent(ctx context.Context, id string, version int64, req models.AssignEventRequest) (models.EventResponse, error)
+	UnassignEvent(ctx context.Context, id string, version int64) (models.EventResponse, error)
+	AcknowledgeEscalation(ctx context.Context, id string, version int64) (models.EventResponse, error)
+	EscalateEvents(ctx context.Context) (int, error)
+	TransitionEvent(ctx context.Context, id string, version int64, status models.EventStatus, req models.TransitionEventRequest) (models.EventResponse, error)
+	BulkEvents(ctx context.Context, req models.BulkEventRequest) (models.BulkEventResponse, error)
+	GetEventTypes(ctx context.Context) ([]string, error)
+	GetEventStatus(ctx context.Context) []models.EventStatusInfo
+	GetEventPriorities(ctx context.Context) []string
 	GetEventManagementStatus(ctx context.Context) []string
 	SeedEvents(ctx context.Context) error
 	GetEventsRequiringManagement(ctx context.Context) ([]models.EventResponse, error)
@@ -30,29 +63,115 @@ type EventService interface {
 
 // eventService implementa EventService
 type eventService struct {
-	repository repositories.EventRepository
+	repository        repositories.EventRepository
+	historyRepository repositories.HistoryRepository
+	eventTypeService  EventTypeService
+	ruleService       RuleService
+	teamService       TeamService
+	slaService        SLAService
+	escalationService EscalationService
+	commentService    CommentService
+	blobStore         storage.BlobStore
+	cfg               *config.Config
 }
 
 // NewEventService crea una nueva instancia de EventService
-func NewEventService(repository repositories.EventRepository) EventService {
+func NewEventService(repository repositories.EventRepository, historyRepository repositories.HistoryRepository, eventTypeService EventTypeService, ruleService RuleService, teamService TeamService, slaService SLAService, escalationService EscalationService, commentService CommentService, blobStore storage.BlobStore, cfg *config.Config) EventService {
 	return &eventService{
-		repository: repository,
+		repository:        repository,
+		historyRepository: historyRepository,
+		eventTypeService:  eventTypeService,
+		ruleService:       ruleService,
+		teamService:       teamService,
+		slaService:        slaService,
+		escalationService: escalationService,
+		commentService:    commentService,
+		blobStore:         blobStore,
+		cfg:               cfg,
 	}
 }
 
-// GetAllEvents recupera todos los eventos
-func (s *eventService) GetAllEvents(ctx context.Context) ([]models.EventResponse, error) {
-	events, err := s.repository.FindAll(ctx)
+// GetAllEvents recupera una página de eventos que cumplen el filtro
+func (s *eventService) GetAllEvents(ctx context.Context, filter models.EventFilter, opts models.ListOptions) (models.EventListResponse, error) {
+	if err := s.validateEventFilter(ctx, filter); err != nil {
+		return models.EventListResponse{}, err
+	}
+
+	events, total, err := s.repository.FindByFilter(ctx, filter, keysetOptions(opts))
 	if err != nil {
-		return nil, err
+		return models.EventListResponse{}, err
 	}
 
-	var responses []models.EventResponse
-	for _, event := range events {
-		responses = append(responses, mapEventToResponse(event))
+	return newEventListResponse(events, total, opts, len(opts.Sort) == 0), nil
+}
+
+// SearchEvents busca eventos por texto en su nombre y descripción, ordenados por relevancia
+func (s *eventService) SearchEvents(ctx context.Context, text string, filter models.EventFilter, opts models.ListOptions) (models.EventListResponse, error) {
+	text = strings.TrimSpace(text)
+	if text == "" {
+		return models.EventListResponse{}, apierror.NewError(apierror.ValidationFail, "el texto de búsqueda es obligatorio")
 	}
 
-	return responses, nil
+	if opts.Cursor != nil {
+		return models.EventListResponse{}, apierror.NewError(apierror.ValidationFail, "la búsqueda no admite paginación por cursor")
+	}
+
+	if err := s.validateEventFilter(ctx, filter); err != nil {
+		return models.EventListResponse{}, err
+	}
+
+	events, total, err := s.repository.Search(ctx, text, filter, opts)
+	if err != nil {
+		return models.EventListResponse{}, err
+	}
+
+	return newEventListResponse(events, total, opts, false), nil
+}
+
+// GetTriageQueue recupera una página de eventos pendientes ordenados por prioridad y antigüedad
+func (s *eventService) GetTriageQueue(ctx context.Context, opts models.ListOptions) (models.EventListResponse, error) {
+	events, total, err := s.repository.FindQueue(ctx, opts)
+	if err != nil {
+		return models.EventListResponse{}, err
+	}
+
+	return newEventListResponse(events, total, opts, false), nil
+}
+
+// GetEventStats calcula las estadísticas de los eventos que cumplen el filtro
+func (s *eventService) GetEventStats(ctx context.Context, filter models.EventFilter, opts models.EventStatsOptions) (models.EventStats, error) {
+	if err := s.validateEventFilter(ctx, filter); err != nil {
+		return models.EventStats{}, err
+	}
+
+	// Sin dimensiones explícitas se calculan todas
+	if len(opts.GroupBy) == 0 {
+		opts.GroupBy = []models.StatsGroup{models.GroupByType, models.GroupByStatus, models.GroupByManagementStatus}
+	}
+
+	for _, group := range opts.GroupBy {
+		switch group {
+		case models.GroupByType, models.GroupByStatus, models.GroupByManagementStatus:
+		default:
+			return models.EventStats{}, apierror.NewError(apierror.ValidationFail, "dimensión de agrupación no válida: "+string(group))
+		}
+	}
+
+	switch opts.Interval {
+	case "", models.IntervalDay, models.IntervalWeek, models.IntervalMonth:
+	default:
+		return models.EventStats{}, apierror.NewError(apierror.ValidationFail, "intervalo no válido: "+string(opts.Interval))
+	}
+
+	switch opts.DateField {
+	case "":
+		opts.DateField = models.StatsDateFieldCreatedAt
+	case models.StatsDateFieldDate, models.StatsDateFieldCreatedAt:
+	default:
+		return models.EventStats{}, apierror.NewError(apierror.ValidationFail, "campo de fecha no válido: "+string(opts.DateField))
+	}
+
+	return s.repository.Stats(ctx, filter, opts)
 }
 
 // GetEventByID recupera un evento por su ID
@@ -65,46 +184,77 @@ func (s *eventService) GetEventByID(ctx context.Context, id string) (models.Even
 	return mapEventToResponse(event), nil
 }
 
+// GetEventHistory recupera una página del historial de cambios de un evento.
+// El historial se conserva aunque el evento haya sido eliminado.
+func (s *eventService) GetEventHistory(ctx context.Context, id string, opts models.ListOptions) (models.EventHistoryListResponse, error) {
+	entries, total, err := s.historyRepository.FindByEventID(ctx, id, opts)
+	if err != nil {
+		return models.EventHistoryListResponse{}, err
+	}
+
+	if entries == nil {
+		entries = []models.EventHistoryEntry{}
+	}
+
+	return models.EventHistoryListResponse{
+		Data:       entries,
+		Pagination: models.NewPagination(opts, total),
+	}, nil
+}
+
 // CreateEvent crea un nuevo evento
 func (s *eventService) CreateEvent(ctx context.Context, req models.CreateEventRequest) (models.EventResponse, error) {
-	// Validar tipo de evento
-	if !isValidEventType(req.Type) {
-		return models.EventResponse{}, apierror.NewError(apierror.ValidationFail, "tipo de evento no válido")
+	// Validar tipo de evento, prioridad y severidad
+	eventType, err := s.validateCreateEventRequest(ctx, req, true, true)
+	if err != nil {
+		return models.EventResponse{}, err
 	}
 
-	event := models.Event{
-		Name:        req.Name,
-		Type:        req.Type,
-		Description: req.Description,
-		Date:        req.Date,
-		Status:      models.StatusPending,
+	policy, err := s.slaService.GetPolicy(ctx, req.Type)
+	if err != nil {
+		return models.EventResponse{}, err
 	}
 
+	event := newEvent(req, eventType)
+	setReviewDue(&event, policy, time.Now())
+
 	createdEvent, err := s.repository.Create(ctx, event)
 	if err != nil {
 		return models.EventResponse{}, err
 	}
 
+	s.recordHistory(ctx, models.OperationCreate, nil, &createdEvent)
+
 	return mapEventToResponse(createdEvent), nil
 }
 
-// UpdateEvent actualiza un evento existente
-func (s *eventService) UpdateEvent(ctx context.Context, id string, req models.UpdateEventRequest) (models.EventResponse, error) {
+// UpdateEvent actualiza un evento existente en la versión indicada
+func (s *eventService) UpdateEvent(ctx context.Context, id string, version int64, req models.UpdateEventRequest) (models.EventResponse, error) {
 	existingEvent, err := s.repository.FindByID(ctx, id)
 	if err != nil {
 		return models.EventResponse{}, err
 	}
 
+	if err := checkVersion(existingEvent, version); err != nil {
+		return models.EventResponse{}, err
+	}
+	before := existingEvent
+
 	// Actualizar solo los campos proporcionados
 	if req.Name != "" {
 		existingEvent.Name = req.Name
 	}
 
-	if req.Type != "" {
-		if !isValidEventType(req.Type) {
-			return models.EventResponse{}, apierror.NewError(apierror.ValidationFail, "tipo de evento no válido")
+	if req.Type != "" && req.Type != existingEvent.Type {
+		if _, err := s.eventTypeService.ValidateEventType(ctx, req.Type, true); err != nil {
+			return models.EventResponse{}, err
 		}
 		existingEvent.Type = req.Type
+
+		// Los plazos se calculan de nuevo con la política del nuevo tipo
+		if err := s.recomputeSLA(ctx, &existingEvent); err != nil {
+			return models.EventResponse{}, err
+		}
 	}
 
 	if req.Description != "" {
@@ -115,89 +265,346 @@ func (s *eventService) UpdateEvent(ctx context.Context, id string, req models.Up
 		existingEvent.Date = req.Date
 	}
 
+	if req.Priority != "" {
+		if !isValidPriority(req.Priority) {
+			return models.EventResponse{}, apierror.NewError(apierror.ValidationFail, "prioridad no válida")
+		}
+		existingEvent.Priority = req.Priority
+	}
+
+	if req.Severity != nil {
+		existingEvent.Severity = *req.Severity
+	}
+
+	if req.Tags != nil {
+		existingEvent.Tags = normalizeTags(req.Tags)
+		if err := validateTags(existingEvent.Tags); err != nil {
+			return models.EventResponse{}, err
+		}
+	}
+
+	if req.Labels != nil {
+		existingEvent.Labels = normalizeLabels(req.Labels)
+		if err := validateLabels(existingEvent.Labels); err != nil {
+			return models.EventResponse{}, err
+		}
+	}
+
+	if req.Attributes != nil {
+		existingEvent.Attributes = normalizeAttributes(req.Attributes)
+	}
+
+	// Los campos personalizados se validan con el esquema vigente del tipo solo si cambian ellos o
+	// el tipo, para que los eventos anteriores al esquema puedan seguir editándose
+	if existingEvent.Type != before.Type || attributesChanged(before.Attributes, existingEvent.Attributes) {
+		if err := s.eventTypeService.ValidateAttributes(ctx, existingEvent.Type, existingEvent.Attributes); err != nil {
+			return models.EventResponse{}, err
+		}
+	}
+
 	updatedEvent, err := s.repository.Update(ctx, id, existingEvent)
 	if err != nil {
 		return models.EventResponse{}, err
 	}
 
+	s.recordHistory(ctx, models.OperationUpdate, &before, &updatedEvent)
+
 	return mapEventToResponse(updatedEvent), nil
 }
 
-// DeleteEvent elimina un evento
-func (s *eventService) DeleteEvent(ctx context.Context, id string) error {
-	return s.repository.Delete(ctx, id)
+// DeleteEvent envía a la papelera un evento en la versión indicada
+func (s *eventService) DeleteEvent(ctx context.Context, id string, version int64) error {
+	existingEvent, err := s.repository.FindByID(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if err := checkVersion(existingEvent, version); err != nil {
+		return err
+	}
+
+	deletedEvent, err := s.repository.Delete(ctx, id, existingEvent.Version)
+	if err != nil {
+		return err
+	}
+
+	s.recordHistory(ctx, models.OperationDelete, &existingEvent, &deletedEvent)
+	s.commentService.ArchiveEventComments(ctx, deletedEvent.ID)
+
+	return nil
+}
+
+// GetDeletedEvents recupera una página de los eventos de la papelera
+func (s *eventService) GetDeletedEvents(ctx context.Context, opts models.ListOptions) (models.EventListResponse, error) {
+	if opts.Cursor != nil {
+		return models.EventListResponse{}, apierror.NewError(apierror.ValidationFail, "la papelera no admite paginación por cursor")
+	}
+
+	events, total, err := s.repository.FindDeleted(ctx, opts)
+	if err != nil {
+		return models.EventListResponse{}, err
+	}
+
+	return newEventListResponse(events, total, opts, false), nil
 }
 
-// ReviewEvent revisa un evento y asigna un estado de gestión automáticamente
-func (s *eventService) ReviewEvent(ctx context.Context, id string, req models.ReviewEventRequest) (models.EventResponse, error) {
+// RestoreEvent saca un evento de la papelera
+func (s *eventService) RestoreEvent(ctx context.Context, id string) (models.EventResponse, error) {
+	deletedEvent, err := s.repository.FindDeletedByID(ctx, id)
+	if err != nil {
+		return models.EventResponse{}, err
+	}
+
+	restoredEvent, err := s.repository.Restore(ctx, id)
+	if err != nil {
+		return models.EventResponse{}, err
+	}
+
+	s.recordHistory(ctx, models.OperationRestore, &deletedEvent, &restoredEvent)
+	s.commentService.RestoreEventComments(ctx, restoredEvent.ID)
+
+	return mapEventToResponse(restoredEvent), nil
+}
+
+// PurgeDeletedEvents elimina definitivamente los eventos que llevan en la papelera más tiempo que
+// la retención indicada, junto a sus comentarios archivados y el contenido de sus adjuntos, y
+// registra la purga en el historial de cada evento. Los eventos se procesan en lotes de como
+// máximo PurgeBatchSize eventos hasta vaciar los que superan la retención.
+func (s *eventService) PurgeDeletedEvents(ctx context.Context, retention time.Duration) (int64, error) {
+	deletedBefore := time.Now().Add(-retention)
+
+	var total int64
+	for {
+		events, err := s.repository.FindPurgeable(ctx, deletedBefore, s.cfg.PurgeBatchSize)
+		if err != nil {
+			return total, err
+		}
+		if len(events) == 0 {
+			break
+		}
+
+		purgedIDs, err := s.purgeEvents(ctx, events, deletedBefore)
+		total += int64(len(purgedIDs))
+		if err != nil {
+			return total, err
+		}
+
+		// Un lote incompleto es el último; si no se eliminó ningún evento del lote, se deja el
+		// resto para la siguiente purga para no repetir el mismo lote
+		if len(events) < s.cfg.PurgeBatchSize || len(purgedIDs) == 0 {
+			break
+		}
+	}
+
+	return total, nil
+}
+
+// purgeEvents elimina definitivamente un lote de eventos de la papelera, sus comentarios y el
+// contenido de sus adjuntos, y registra la purga en su historial. Devuelve los IDs de los eventos
+// eliminados, también si falla la eliminación de sus comentarios.
+func (s *eventService) purgeEvents(ctx context.Context, events []models.Event, deletedBefore time.Time) ([]primitive.ObjectID, error) {
+	ids := make([]primitive.ObjectID, len(events))
+	for i, event := range events {
+		ids[i] = event.ID
+	}
+
+	purgedIDs, err := s.repository.PurgeDeleted(ctx, ids, deletedBefore)
+	if err != nil {
+		return nil, err
+	}
+
+	// Solo se registran en el historial y se elimina el contenido de los adjuntos de los eventos
+	// que se han eliminado
+	purged := make(map[primitive.ObjectID]bool, len(purgedIDs))
+	for _, id := range purgedIDs {
+		purged[id] = true
+	}
+
+	var attachments []models.Attachment
+	entries := make([]models.EventHistoryEntry, 0, len(purgedIDs))
+	for i := range events {
+		if purged[events[i].ID] {
+			attachments = append(attachments, events[i].Attachments...)
+			entries = append(entries, newHistoryEntry(ctx, models.OperationPurge, &events[i], nil))
+		}
+	}
+	s.deleteBlobs(ctx, attachments...)
+	s.recordHistoryEntries(ctx, entries)
+
+	// Solo se eliminan los comentarios de los eventos purgados: los de un evento que sigue en la
+	// papelera se conservan por si se restaura
+	if _, err := s.commentService.PurgeEventComments(ctx, purgedIDs...); err != nil {
+		return purgedIDs, err
+	}
+
+	return purgedIDs, nil
+}
+
+// ReviewEvent revisa un evento y asigna su estado de gestión. Si la solicitud indica un estado
+// de gestión, este sustituye a la clasificación automática según las reglas de gestión.
+func (s *eventService) ReviewEvent(ctx context.Context, id string, version int64, req models.ReviewEventRequest) (models.EventResponse, error) {
 	existingEvent, err := s.repository.FindByID(ctx, id)
 	if err != nil {
 		return models.EventResponse{}, err
 	}
 
-	// Determinar automáticamente el estado de gestión basado en el tipo de evento
-	var managementStatus models.ManagementStatus
-	switch existingEvent.Type {
-	case models.TypeEmergency, models.TypeAlert:
-		managementStatus = models.ManagementRequired
-	case models.TypeMaintenance, models.TypeNotification, models.TypeInfo:
-		managementStatus = models.ManagementNotRequired
-	default:
-		return models.EventResponse{}, apierror.NewError(apierror.ValidationFail, "tipo de evento no reconocido")
+	if err := checkVersion(existingEvent, version); err != nil {
+		return models.EventResponse{}, err
 	}
+	before := existingEvent
 
-	// Actualizar estado y estado de gestión
-	existingEvent.Status = models.StatusReviewed
-	existingEvent.ManagementStatus = managementStatus
+	// El comentario de la revisión se valida antes de revisar el evento
+	if req.Comment != "" {
+		if _, err := commentAuthor(ctx); err != nil {
+			return models.EventResponse{}, err
+		}
+		if _, err := normalizeCommentBody(req.Comment); err != nil {
+			return models.EventResponse{}, err
+		}
+	}
+
+	if err := s.applyReview(ctx, &existingEvent, req, nil); err != nil {
+		return models.EventResponse{}, err
+	}
 
 	updatedEvent, err := s.repository.Update(ctx, id, existingEvent)
 	if err != nil {
 		return models.EventResponse{}, err
 	}
 
+	s.recordHistory(ctx, models.OperationReview, &before, &updatedEvent)
+
+	if req.Comment != "" {
+		// Un fallo al publicar el comentario no revierte la revisión, que ya se ha aplicado
+		if _, err := s.commentService.CreateComment(ctx, id, models.CreateCommentRequest{Body: req.Comment}); err != nil {
+			log.Printf("Error al publicar el comentario de la revisión del evento %s: %v\n", id, err)
+		} else {
+			updatedEvent.CommentCount++
+		}
+	}
+
 	return mapEventToResponse(updatedEvent), nil
 }
 
-// UnreviewEvent revierte la revisión de un evento
-func (s *eventService) UnreviewEvent(ctx context.Context, id string) (models.EventResponse, error) {
+// UnreviewEvent revierte la revisión de un evento en la versión indicada
+func (s *eventService) UnreviewEvent(ctx context.Context, id string, version int64) (models.EventResponse, error) {
 	existingEvent, err := s.repository.FindByID(ctx, id)
 	if err != nil {
 		return models.EventResponse{}, err
 	}
 
-	// Verificar que el evento esté en estado revisado
-	if existingEvent.Status != models.StatusReviewed {
-		return models.EventResponse{}, apierror.NewError(apierror.ValidationFail, "el evento no está en estado revisado")
+	if err := checkVersion(existingEvent, version); err != nil {
+		return models.EventResponse{}, err
 	}
+	before := existingEvent
 
-	// Cambiar el estado a pendiente y eliminar el estado de gestión
-	existingEvent.Status = models.StatusPending
-	existingEvent.ManagementStatus = "" // Eliminar el estado de gestión
+	if err := applyUnreview(&existingEvent); err != nil {
+		return models.EventResponse{}, err
+	}
+
+	// El evento vuelve a estar pendiente con un nuevo plazo de revisión
+	if err := s.recomputeSLA(ctx, &existingEvent); err != nil {
+		return models.EventResponse{}, err
+	}
 
 	updatedEvent, err := s.repository.Update(ctx, id, existingEvent)
 	if err != nil {
 		return models.EventResponse{}, err
 	}
 
+	s.recordHistory(ctx, models.OperationUnreview, &before, &updatedEvent)
+
 	return mapEventToResponse(updatedEvent), nil
 }
 
-// GetEventTypes devuelve los tipos de eventos disponibles
-func (s *eventService) GetEventTypes(ctx context.Context) []string {
-	return []string{
-		string(models.TypeEmergency),
-		string(models.TypeMaintenance),
-		string(models.TypeNotification),
-		string(models.TypeAlert),
-		string(models.TypeInfo),
+// AssignEvent asigna un evento a un usuario, a un equipo o a ambos
+func (s *eventService) AssignEvent(ctx context.Context, id string, version int64, req models.AssignEventRequest) (models.EventResponse, error) {
+	existingEvent, err := s.repository.FindByID(ctx, id)
+	if err != nil {
+		return models.EventResponse{}, err
+	}
+
+	if err := checkVersion(existingEvent, version); err != nil {
+		return models.EventResponse{}, err
+	}
+	before := existingEvent
+
+	// Si no se indica el equipo, el evento conserva el suyo
+	if req.TeamID == nil {
+		teamID := existingEvent.TeamID
+		req.TeamID = &teamID
+	}
+
+	assignment, err := s.teamService.ResolveAssignment(ctx, req)
+	if err != nil {
+		return models.EventResponse{}, err
+	}
+
+	existingEvent.AssigneeID = assignment.AssigneeID
+	existingEvent.TeamID = assignment.TeamID
+
+	updatedEvent, err := s.repository.Update(ctx, id, existingEvent)
+	if err != nil {
+		return models.EventResponse{}, err
 	}
+
+	s.recordHistory(ctx, models.OperationAssign, &before, &updatedEvent)
+
+	return mapEventToResponse(updatedEvent), nil
 }
 
-// GetEventStatus devuelve los estados de eventos disponibles
-func (s *eventService) GetEventStatus(ctx context.Context) []string {
+// UnassignEvent elimina el responsable de un evento
+func (s *eventService) UnassignEvent(ctx context.Context, id string, version int64) (models.EventResponse, error) {
+	existingEvent, err := s.repository.FindByID(ctx, id)
+	if err != nil {
+		return models.EventResponse{}, err
+	}
+
+	if err := checkVersion(existingEvent, version); err != nil {
+		return models.EventResponse{}, err
+	}
+
+	if existingEvent.AssigneeID == "" && existingEvent.TeamID == "" {
+		return models.EventResponse{}, apierror.NewError(apierror.ValidationFail, "el evento no está asignado")
+	}
+	before := existingEvent
+
+	existingEvent.AssigneeID = ""
+	existingEvent.TeamID = ""
+
+	updatedEvent, err := s.repository.Update(ctx, id, existingEvent)
+	if err != nil {
+		return models.EventResponse{}, err
+	}
+
+	s.recordHistory(ctx, models.OperationUnassign, &before, &updatedEvent)
+
+	return mapEventToResponse(updatedEvent), nil
+}
+
+// GetEventTypes devuelve los códigos de los tipos de evento activos en el registro
+func (s *eventService) GetEventTypes(ctx context.Context) ([]string, error) {
+	eventTypes, err := s.eventTypeService.GetEventTypes(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	codes := make([]string, 0, len(eventTypes))
+	for _, eventType := range eventTypes {
+		if eventType.Active {
+			codes = append(codes, string(eventType.Code))
+		}
+	}
+	return codes, nil
+}
+
+// GetEventPriorities devuelve las prioridades de evento disponibles, de la más urgente a la menos urgente
+func (s *eventService) GetEventPriorities(ctx context.Context) []string {
 	return []string{
-		string(models.StatusPending),
-		string(models.StatusReviewed),
+		string(models.PriorityP1),
+		string(models.PriorityP2),
+		string(models.PriorityP3),
+		string(models.PriorityP4),
 	}
 }
 
@@ -223,6 +630,13 @@ func (s *eventService) SeedEvents(ctx context.Context) error {
 			Description: "Mantenimiento programado del sistema para actualización",
 			Date:        time.Now().AddDate(0, 0, 7),
 			Status:      models.StatusPending,
+			Attributes: map[string]interface{}{
+				"window": map[string]interface{}{
+					"start": time.Now().AddDate(0, 0, 7).UTC().Format(time.RFC3339),
+					"end":   time.Now().AddDate(0, 0, 7).Add(4 * time.Hour).UTC().Format(time.RFC3339),
+				},
+				"affectedSystems": []interface{}{"facturacion", "base-de-datos"},
+			},
 		},
 		{
 			ID:          primitive.NewObjectID(),
@@ -231,6 +645,10 @@ func (s *eventService) SeedEvents(ctx context.Context) error {
 			Description: "Detección de posible intrusión en el sistema",
 			Date:        time.Now().AddDate(0, 0, -2),
 			Status:      models.StatusPending,
+			Attributes: map[string]interface{}{
+				"source":    "ids",
+				"threshold": 5,
+			},
 		},
 		{
 			ID:          primitive.NewObjectID(),
@@ -275,24 +693,50 @@ func (s *eventService) SeedEvents(ctx context.Context) error {
 		},
 	}
 
-	err := s.repository.BulkInsert(ctx, events)
+	policies, err := s.slaPolicies(ctx)
 	if err != nil {
 		return err
 	}
 
-	// Revisar inmediatamente los eventos específicos
+	now := time.Now()
+	for i := range events {
+		eventType, err := s.eventTypeService.ValidateEventType(ctx, events[i].Type, false)
+		if err != nil {
+			return err
+		}
+
+		applyDefaultPriority(&events[i], eventType)
+		if events[i].Status == models.StatusPending {
+			setReviewDue(&events[i], policies[events[i].Type], now)
+		}
+	}
+
+	errs, err := s.repository.BulkInsert(ctx, events)
+	if err != nil {
+		return err
+	}
+
+	for _, err := range errs {
+		if err != nil {
+			return err
+		}
+	}
+
+	created := make([]*models.Event, len(events))
+	for i := range events {
+		created[i] = &events[i]
+	}
+	s.recordHistoryMany(ctx, models.OperationCreate, make([]*models.Event, len(events)), created)
+
+	// Revisar inmediatamente los eventos específicos con la clasificación automática
 	// Evento Info
-	_, err = s.ReviewEvent(ctx, pendingId1.Hex(), models.ReviewEventRequest{
-		ManagementStatus: models.ManagementNotRequired,
-	})
+	_, err = s.ReviewEvent(ctx, pendingId1.Hex(), models.AnyVersion, models.ReviewEventRequest{})
 	if err != nil {
 		return err
 	}
 
 	// Evento Emergency
-	_, err = s.ReviewEvent(ctx, pendingId2.Hex(), models.ReviewEventRequest{
-		ManagementStatus: models.ManagementRequired,
-	})
+	_, err = s.ReviewEvent(ctx, pendingId2.Hex(), models.AnyVersion, models.ReviewEventRequest{})
 	if err != nil {
 		return err
 	}
@@ -330,30 +774,340 @@ func (s *eventService) GetEventsNotRequiringManagement(ctx context.Context) ([]m
 	return responses, nil
 }
 
+// keysetOptions ajusta las opciones de paginación por cursor para solicitar un evento
+// adicional que permite saber si existe una página siguiente
+func keysetOptions(opts models.ListOptions) models.ListOptions {
+	if opts.Cursor != nil {
+		opts.PageSize++
+	}
+	return opts
+}
+
+// newEventListResponse construye la respuesta paginada de un listado de eventos.
+// Si keyset es verdadero el listado sigue el orden por defecto y se informa el cursor
+// de la página siguiente para poder continuar con paginación por clave.
+func newEventListResponse(events []models.Event, total int64, opts models.ListOptions, keyset bool) models.EventListResponse {
+	pagination := models.NewPagination(opts, total)
+
+	if opts.Cursor != nil {
+		if int64(len(events)) > opts.PageSize {
+			events = events[:opts.PageSize]
+			pagination.NextCursor = models.NewEventCursor(events[len(events)-1]).Encode()
+		}
+	} else if keyset && pagination.HasNext() && len(events) > 0 {
+		pagination.NextCursor = models.NewEventCursor(events[len(events)-1]).Encode()
+	}
+
+	responses := make([]models.EventResponse, 0, len(events))
+	for _, event := range events {
+		responses = append(responses, mapEventToResponse(event))
+	}
+
+	return models.EventListResponse{
+		Data:       responses,
+		Pagination: pagination,
+	}
+}
+
 // mapEventToResponse mapea un Event a un EventResponse
 func mapEventToResponse(event models.Event) models.EventResponse {
+	attachments := event.Attachments
+	if attachments == nil {
+		attachments = []models.Attachment{}
+	}
+
+	tags := event.Tags
+	if tags == nil {
+		tags = []string{}
+	}
+
+	labels := event.Labels
+	if labels == nil {
+		labels = map[string]string{}
+	}
+
+	attributes := event.Attributes
+	if attributes == nil {
+		attributes = map[string]interface{}{}
+	}
+
 	return models.EventResponse{
-		ID:               event.ID.Hex(),
-		Name:             event.Name,
-		Type:             string(event.Type),
-		Description:      event.Description,
-		Date:             event.Date,
-		Status:           string(event.Status),
-		ManagementStatus: string(event.ManagementStatus),
-		CreatedAt:        event.CreatedAt,
-		UpdatedAt:        event.UpdatedAt,
+		ID:                 event.ID.Hex(),
+		Name:               event.Name,
+		Type:               string(event.Type),
+		Description:        event.Description,
+		Date:               event.Date,
+		Status:             string(event.Status),
+		Priority:           string(event.Priority),
+		Severity:           event.Severity,
+		Tags:               tags,
+		Labels:             labels,
+		Attributes:         attributes,
+		ManagementStatus:   string(event.ManagementStatus),
+		ManagementRuleID:   event.ManagementRuleID,
+		ManagementRuleName: event.ManagementRuleName,
+		ManagementSource:   string(event.ManagementSource),
+		Justification:      event.Justification,
+		AssigneeID:         event.AssigneeID,
+		TeamID:             event.TeamID,
+		StatusNote:         event.StatusNote,
+		ResolutionNote:     event.ResolutionNote,
+		StartedAt:          event.StartedAt,
+		ResolvedAt:         event.ResolvedAt,
+		ClosedAt:           event.ClosedAt,
+		ReopenedAt:         event.ReopenedAt,
+		SLA:                newSLAStatus(event, time.Now()),
+		Escalation:         event.Escalation,
+		CommentCount:       event.CommentCount,
+		Attachments:        attachments,
+		CreatedAt:          event.CreatedAt,
+		UpdatedAt:          event.UpdatedAt,
+		DeletedAt:          event.DeletedAt,
+		Version:            event.Version,
+		Score:              event.Score,
+	}
+}
+
+// applyReview marca un evento como revisado. Si la solicitud indica el estado de gestión la
+// revisión es manual; en otro caso se evalúan las reglas de gestión configuradas.
+func (s *eventService) applyReview(ctx context.Context, event *models.Event, req models.ReviewEventRequest, rotation *models.AssignmentRotation) error {
+	if err := checkTransition(event.Status, models.StatusReviewed); err != nil {
+		return err
+	}
+
+	if req.ManagementStatus != "" {
+		// Revisión manual: el revisor decide el estado de gestión y debe justificarlo
+		if !isValidManagementStatus(req.ManagementStatus) {
+			return apierror.NewError(apierror.ValidationFail, "estado de gestión no válido: "+string(req.ManagementStatus))
+		}
+
+		justification := strings.TrimSpace(req.Justification)
+		if justification == "" {
+			return apierror.NewError(apierror.ValidationFail, "la justificación es obligatoria al indicar el estado de gestión manualmente")
+		}
+
+		event.ManagementStatus = req.ManagementStatus
+		event.ManagementSource = models.ManagementSourceManual
+		event.Justification = justification
+		event.ManagementRuleID = ""
+		event.ManagementRuleName = ""
+	} else {
+		// Determinar automáticamente el estado de gestión evaluando las reglas configuradas
+		evaluation, err := s.ruleService.Evaluate(ctx, *event)
+		if err != nil {
+			return err
+		}
+
+		event.ManagementStatus = evaluation.ManagementStatus
+		event.ManagementSource = models.ManagementSourceAutomatic
+		event.Justification = ""
+		event.ManagementRuleID = evaluation.RuleID
+		event.ManagementRuleName = evaluation.RuleName
+	}
+
+	event.Status = models.StatusReviewed
+	event.StatusNote = ""
+
+	policy, err := s.slaService.GetPolicy(ctx, event.Type)
+	if err != nil {
+		return err
+	}
+	setResolveDue(event, policy, time.Now())
+
+	// Los eventos que pasan a requerir gestión sin responsable se asignan por turnos a un equipo.
+	// En las operaciones masivas el turno se reserva en rotation y se consume al aplicarlas.
+	if event.ManagementStatus == models.ManagementRequired && event.AssigneeID == "" {
+		var assignment models.Assignment
+		var ok bool
+		if rotation != nil {
+			assignment, ok = s.teamService.PlanAutoAssign(ctx, *event, rotation)
+		} else {
+			assignment, ok = s.teamService.AutoAssign(ctx, *event)
+		}
+		if ok {
+			event.AssigneeID = assignment.AssigneeID
+			event.TeamID = assignment.TeamID
+		}
+	}
+
+	return nil
+}
+
+// applyUnreview devuelve un evento revisado al estado pendiente y elimina su estado de gestión
+func applyUnreview(event *models.Event) error {
+	// Solo los eventos revisados pueden volver a estar pendientes
+	if err := checkTransition(event.Status, models.StatusPending); err != nil {
+		return err
 	}
+
+	// Cambiar el estado a pendiente y eliminar el estado de gestión
+	event.Status = models.StatusPending
+	event.ManagementStatus = "" // Eliminar el estado de gestión
+	event.ManagementRuleID = ""
+	event.ManagementRuleName = ""
+	event.ManagementSource = ""
+	event.Justification = ""
+	event.StatusNote = ""
+	event.ResolveDueAt = nil
+	event.ResolveBreachedAt = nil
+	return nil
 }
 
-// isValidEventType valida si un tipo de evento es válido
-func isValidEventType(eventType models.EventType) bool {
-	validTypes := map[models.EventType]bool{
-		models.TypeEmergency:    true,
-		models.TypeMaintenance:  true,
-		models.TypeNotification: true,
-		models.TypeAlert:        true,
-		models.TypeInfo:         true,
+// newEvent construye un evento pendiente a partir de una solicitud de creación
+func newEvent(req models.CreateEventRequest, eventType models.EventTypeDefinition) models.Event {
+	event := models.Event{
+		Name:        req.Name,
+		Type:        req.Type,
+		Description: req.Description,
+		Date:        req.Date,
+		Status:      models.StatusPending,
+		Priority:    req.Priority,
+		Tags:        normalizeTags(req.Tags),
+		Labels:      normalizeLabels(req.Labels),
+		Attributes:  normalizeAttributes(req.Attributes),
 	}
 
-	return validTypes[eventType]
+	if req.Severity != nil {
+		event.Severity = *req.Severity
+	}
+
+	applyDefaultPriority(&event, eventType)
+	return event
+}
+
+// applyDefaultPriority asigna la prioridad y la severidad predeterminadas del tipo cuando no se han indicado
+func applyDefaultPriority(event *models.Event, eventType models.EventTypeDefinition) {
+	if event.Priority == "" {
+		event.Priority = eventType.DefaultPriority
+	}
+
+	if event.Severity == 0 {
+		event.Severity = eventType.DefaultSeverity
+	}
+}
+
+// validateCreateEventRequest valida una solicitud de creación con las mismas reglas que se
+// aplican al recibirla en el cuerpo de una petición y devuelve la definición de su tipo.
+// requireActive rechaza los tipos desactivados en el registro.
+func (s *eventService) validateCreateEventRequest(ctx context.Context, req models.CreateEventRequest, requireActive bool, validateAttributes bool) (models.EventTypeDefinition, error) {
+	if err := binding.Validator.ValidateStruct(req); err != nil {
+		return models.EventTypeDefinition{}, apierror.NewError(apierror.ValidationFail, "el evento no es válido: "+err.Error())
+	}
+
+	eventType, err := s.eventTypeService.ValidateEventType(ctx, req.Type, requireActive)
+	if err != nil {
+		return models.EventTypeDefinition{}, err
+	}
+
+	if req.Priority != "" && !isValidPriority(req.Priority) {
+		return models.EventTypeDefinition{}, apierror.NewError(apierror.ValidationFail, "prioridad no válida")
+	}
+
+	if err := validateTags(normalizeTags(req.Tags)); err != nil {
+		return models.EventTypeDefinition{}, err
+	}
+
+	if err := validateLabels(normalizeLabels(req.Labels)); err != nil {
+		return models.EventTypeDefinition{}, err
+	}
+
+	if validateAttributes {
+		if err := s.eventTypeService.ValidateAttributes(ctx, req.Type, req.Attributes); err != nil {
+			return models.EventTypeDefinition{}, err
+		}
+	}
+
+	return eventType, nil
+}
+
+// checkVersion verifica que el evento conserve la versión esperada por el cliente
+func checkVersion(event models.Event, version int64) error {
+	if version != models.AnyVersion && event.Version != version {
+		return apierror.NewError(apierror.PreconditionFailed, "la versión del evento no coincide con If-Match")
+	}
+	return nil
+}
+
+// isValidEventStatus valida si un estado de evento es válido
+func isValidEventStatus(status models.EventStatus) bool {
+	validStatus := map[models.EventStatus]bool{
+		models.StatusPending:    true,
+		models.StatusReviewed:   true,
+		models.StatusInProgress: true,
+		models.StatusResolved:   true,
+		models.StatusClosed:     true,
+		models.StatusReopened:   true,
+	}
+
+	return validStatus[status]
+}
+
+// isValidManagementStatus valida si un estado de gestión es válido
+func isValidManagementStatus(managementStatus models.ManagementStatus) bool {
+	validManagementStatus := map[models.ManagementStatus]bool{
+		models.ManagementRequired:    true,
+		models.ManagementNotRequired: true,
+	}
+
+	return validManagementStatus[managementStatus]
+}
+
+// isValidPriority verifica si una prioridad es válida
+func isValidPriority(priority models.Priority) bool {
+	validPriorities := map[models.Priority]bool{
+		models.PriorityP1: true,
+		models.PriorityP2: true,
+		models.PriorityP3: true,
+		models.PriorityP4: true,
+	}
+
+	return validPriorities[priority]
+}
+
+// validateEventFilter valida los valores y rangos de un filtro de eventos. Se admiten los tipos
+// desactivados para poder consultar los eventos que los usan.
+func (s *eventService) validateEventFilter(ctx context.Context, filter models.EventFilter) error {
+	for _, eventType := range filter.Types {
+		if _, err := s.eventTypeService.ValidateEventType(ctx, eventType, false); err != nil {
+			return err
+		}
+	}
+
+	for _, status := range filter.Statuses {
+		if !isValidEventStatus(status) {
+			return apierror.NewError(apierror.ValidationFail, "estado de evento no válido: "+string(status))
+		}
+	}
+
+	for _, managementStatus := range filter.ManagementStatuses {
+		if !isValidManagementStatus(managementStatus) {
+			return apierror.NewError(apierror.ValidationFail, "estado de gestión no válido: "+string(managementStatus))
+		}
+	}
+
+	if filter.DateFrom != nil && filter.DateTo != nil && filter.DateFrom.After(*filter.DateTo) {
+		return apierror.NewError(apierror.ValidationFail, "dateFrom no puede ser posterior a dateTo")
+	}
+
+	if filter.CreatedFrom != nil && filter.CreatedTo != nil && filter.CreatedFrom.After(*filter.CreatedTo) {
+		return apierror.NewError(apierror.ValidationFail, "createdFrom no puede ser posterior a createdTo")
+	}
+
+	if filter.Unassigned && len(filter.AssigneeIDs) > 0 {
+		return apierror.NewError(apierror.ValidationFail, "unassigned no se puede combinar con assigneeId")
+	}
+
+	for key := range filter.Labels {
+		if !models.LabelKeyPattern.MatchString(key) {
+			return apierror.NewError(apierror.ValidationFail, "clave de atributo no válida: "+key)
+		}
+	}
+
+	for path := range filter.Attributes {
+		if !models.AttributePathPattern.MatchString(path) || strings.Count(path, ".") >= models.MaxAttributePathDepth {
+			return apierror.NewError(apierror.ValidationFail, "ruta de campo personalizado no válida: "+path)
+		}
+	}
+
+	return nil
 }
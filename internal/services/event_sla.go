@@ -0,0 +1,131 @@
+package services
+
+import (
+	"context"
+	"time"
+
+	"events-api/internal/models"
+)
+
+// GetOverdueEvents recupera una página de los eventos cuyo plazo vigente ha vencido
+func (s *eventService) GetOverdueEvents(ctx context.Context, opts models.ListOptions) (models.EventListResponse, error) {
+	events, total, err := s.repository.FindOverdue(ctx, time.Now(), opts)
+	if err != nil {
+		return models.EventListResponse{}, err
+	}
+
+	return newEventListResponse(events, total, opts, false), nil
+}
+
+// FlagSLABreaches marca los eventos cuyo plazo vigente ha vencido desde la última comprobación
+// y devuelve cuántos se han marcado
+func (s *eventService) FlagSLABreaches(ctx context.Context) (int64, error) {
+	return s.repository.FlagSLABreaches(ctx, time.Now())
+}
+
+// slaPolicies devuelve la política vigente de cada tipo de evento
+func (s *eventService) slaPolicies(ctx context.Context) (map[models.EventType]models.SLAPolicy, error) {
+	policies, err := s.slaService.GetPolicies(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	byType := make(map[models.EventType]models.SLAPolicy, len(policies))
+	for _, policy := range policies {
+		byType[policy.Type] = policy
+	}
+	return byType, nil
+}
+
+// setReviewDue calcula el plazo de revisión de un evento que se crea en el instante indicado
+func setReviewDue(event *models.Event, policy models.SLAPolicy, now time.Time) {
+	if policy.TimeToReviewMinutes <= 0 {
+		return
+	}
+
+	dueAt := now.Add(policy.TimeToReview())
+	event.ReviewDueAt = &dueAt
+}
+
+// setResolveDue calcula el plazo de resolución de un evento que se revisa en el instante
+// indicado. Solo tienen plazo de resolución los eventos que requieren gestión, y una nueva
+// revisión no amplía el plazo ya asignado.
+func setResolveDue(event *models.Event, policy models.SLAPolicy, now time.Time) {
+	if event.ManagementStatus != models.ManagementRequired || policy.TimeToResolveMinutes <= 0 {
+		event.ResolveDueAt = nil
+		event.ResolveBreachedAt = nil
+		return
+	}
+
+	if event.ResolveDueAt == nil {
+		dueAt := now.Add(policy.TimeToResolve())
+		event.ResolveDueAt = &dueAt
+	}
+}
+
+// resetSLADue descarta los plazos vigentes de un evento y los calcula de nuevo con la política
+// indicada desde el instante now. Los plazos de un evento resuelto o cerrado no se modifican.
+func resetSLADue(event *models.Event, policy models.SLAPolicy, now time.Time) {
+	switch event.Status {
+	case models.StatusResolved, models.StatusClosed:
+		return
+	case models.StatusPending:
+		event.ReviewDueAt = nil
+		event.ReviewBreachedAt = nil
+		event.ResolveDueAt = nil
+		event.ResolveBreachedAt = nil
+		setReviewDue(event, policy, now)
+	default:
+		event.ResolveDueAt = nil
+		event.ResolveBreachedAt = nil
+		setResolveDue(event, policy, now)
+	}
+}
+
+// recomputeSLA vuelve a calcular los plazos de un evento con la política vigente de su tipo.
+// Se usa cuando el evento vuelve a una fase anterior o cambia de tipo, ya que los plazos
+// anteriores dejan de corresponder a su situación.
+func (s *eventService) recomputeSLA(ctx context.Context, event *models.Event) error {
+	policy, err := s.slaService.GetPolicy(ctx, event.Type)
+	if err != nil {
+		return err
+	}
+
+	resetSLADue(event, policy, time.Now())
+	return nil
+}
+
+// newSLAStatus calcula el estado del plazo vigente de un evento, o nil si no tiene plazo
+func newSLAStatus(event models.Event, now time.Time) *models.SLAStatus {
+	var (
+		phase      models.SLAPhase
+		dueAt      *time.Time
+		breachedAt *time.Time
+	)
+
+	switch event.Status {
+	case models.StatusPending:
+		phase, dueAt, breachedAt = models.SLAPhaseReview, event.ReviewDueAt, event.ReviewBreachedAt
+	case models.StatusResolved, models.StatusClosed:
+		// El plazo se detiene al resolver o cerrar el evento
+		phase, dueAt, breachedAt = models.SLAPhaseResolve, event.ResolveDueAt, event.ResolveBreachedAt
+		if event.ResolvedAt != nil {
+			now = *event.ResolvedAt
+		} else if event.ClosedAt != nil {
+			now = *event.ClosedAt
+		}
+	default:
+		phase, dueAt, breachedAt = models.SLAPhaseResolve, event.ResolveDueAt, event.ResolveBreachedAt
+	}
+
+	if dueAt == nil {
+		return nil
+	}
+
+	return &models.SLAStatus{
+		Phase:     phase,
+		DueAt:     *dueAt,
+		Breached:  breachedAt != nil || now.After(*dueAt),
+		Remaining: int64(dueAt.Sub(now) / time.Second),
+	}
+}
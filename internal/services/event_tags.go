@@ -0,0 +1,164 @@
+package services
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"events-api/internal/apierror"
+	"events-api/internal/models"
+)
+
+// GetEventTags recupera las etiquetas en uso que comienzan por el prefijo indicado, de la más
+// usada a la menos usada, junto al número de eventos que las usan
+func (s *eventService) GetEventTags(ctx context.Context, prefix string, limit int) ([]models.TagCount, error) {
+	if limit <= 0 {
+		limit = models.DefaultTagSuggestions
+	}
+
+	tags, err := s.repository.TagCounts(ctx, strings.ToLower(strings.TrimSpace(prefix)), limit)
+	if err != nil {
+		return nil, err
+	}
+
+	if tags == nil {
+		tags = []models.TagCount{}
+	}
+
+	return tags, nil
+}
+
+// AddEventTags añade etiquetas a un evento en la versión indicada. Las etiquetas que el evento
+// ya tiene se ignoran.
+func (s *eventService) AddEventTags(ctx context.Context, id string, version int64, req models.TagEventRequest) (models.EventResponse, error) {
+	existingEvent, err := s.repository.FindByID(ctx, id)
+	if err != nil {
+		return models.EventResponse{}, err
+	}
+
+	if err := checkVersion(existingEvent, version); err != nil {
+		return models.EventResponse{}, err
+	}
+
+	tags := normalizeTags(append(append([]string{}, existingEvent.Tags...), req.Tags...))
+	if err := validateTags(tags); err != nil {
+		return models.EventResponse{}, err
+	}
+
+	if len(tags) == len(existingEvent.Tags) {
+		return mapEventToResponse(existingEvent), nil
+	}
+
+	updatedEvent, err := s.repository.Patch(ctx, id, existingEvent.Version, map[string]interface{}{"tags": tags})
+	if err != nil {
+		return models.EventResponse{}, err
+	}
+
+	s.recordHistory(ctx, models.OperationTag, &existingEvent, &updatedEvent)
+
+	return mapEventToResponse(updatedEvent), nil
+}
+
+// RemoveEventTag quita una etiqueta de un evento en la versión indicada
+func (s *eventService) RemoveEventTag(ctx context.Context, id string, version int64, tag string) (models.EventResponse, error) {
+	existingEvent, err := s.repository.FindByID(ctx, id)
+	if err != nil {
+		return models.EventResponse{}, err
+	}
+
+	if err := checkVersion(existingEvent, version); err != nil {
+		return models.EventResponse{}, err
+	}
+
+	tag = strings.ToLower(strings.TrimSpace(tag))
+	tags := make([]string, 0, len(existingEvent.Tags))
+	for _, existing := range existingEvent.Tags {
+		if existing != tag {
+			tags = append(tags, existing)
+		}
+	}
+
+	if len(tags) == len(existingEvent.Tags) {
+		return models.EventResponse{}, apierror.NewError(apierror.NotFound, "el evento no tiene la etiqueta "+tag)
+	}
+
+	updatedEvent, err := s.repository.Patch(ctx, id, existingEvent.Version, map[string]interface{}{"tags": tags})
+	if err != nil {
+		return models.EventResponse{}, err
+	}
+
+	s.recordHistory(ctx, models.OperationUntag, &existingEvent, &updatedEvent)
+
+	return mapEventToResponse(updatedEvent), nil
+}
+
+// normalizeTags elimina los espacios exteriores, pasa a minúsculas y elimina las etiquetas vacías
+// y repetidas, conservando el orden en que aparecen
+func normalizeTags(tags []string) []string {
+	if tags == nil {
+		return nil
+	}
+
+	normalized := make([]string, 0, len(tags))
+	seen := make(map[string]bool)
+	for _, tag := range tags {
+		tag = strings.ToLower(strings.TrimSpace(tag))
+		if tag == "" || seen[tag] {
+			continue
+		}
+		seen[tag] = true
+		normalized = append(normalized, tag)
+	}
+
+	return normalized
+}
+
+// normalizeLabels elimina los espacios exteriores de las claves y los valores y pasa las claves a
+// minúsculas
+func normalizeLabels(labels map[string]string) map[string]string {
+	if labels == nil {
+		return nil
+	}
+
+	normalized := make(map[string]string, len(labels))
+	for key, value := range labels {
+		normalized[strings.ToLower(strings.TrimSpace(key))] = strings.TrimSpace(value)
+	}
+
+	return normalized
+}
+
+// validateTags valida el número y el formato de las etiquetas ya normalizadas
+func validateTags(tags []string) error {
+	if len(tags) > models.MaxEventTags {
+		return apierror.NewError(apierror.ValidationFail, "el evento admite como máximo "+strconv.Itoa(models.MaxEventTags)+" etiquetas")
+	}
+
+	for _, tag := range tags {
+		if utf8.RuneCountInString(tag) > models.MaxTagLength || !models.TagPattern.MatchString(tag) {
+			return apierror.NewError(apierror.ValidationFail, "etiqueta no válida: "+tag+" (debe empezar por una letra o un número y contener solo letras, números, puntos, dos puntos, guiones y guiones bajos)")
+		}
+	}
+
+	return nil
+}
+
+// validateLabels valida el número, las claves y los valores de los atributos ya normalizados
+func validateLabels(labels map[string]string) error {
+	if len(labels) > models.MaxEventLabels {
+		return apierror.NewError(apierror.ValidationFail, "el evento admite como máximo "+strconv.Itoa(models.MaxEventLabels)+" atributos")
+	}
+
+	for key, value := range labels {
+		if len(key) > models.MaxLabelKeyLength || !models.LabelKeyPattern.MatchString(key) {
+			return apierror.NewError(apierror.ValidationFail, "clave de atributo no válida: "+key)
+		}
+
+		if value == "" || utf8.RuneCountInString(value) > models.MaxLabelValueLength {
+			return apierror.NewError(apierror.ValidationFail, "valor no válido para el atributo "+key)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,405 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"log"
+	"strings"
+	"sync"
+	"time"
+
+	"events-api/internal/apierror"
+	"events-api/internal/config"
+	"events-api/internal/models"
+	"events-api/internal/repositories"
+
+	"github.com/santhosh-tekuri/jsonschema/v5"
+)
+
+// EventTypeService define las operaciones del registro de tipos de evento. El resto de servicios
+// valida los tipos de evento y obtiene sus valores predeterminados a través de este servicio.
+type EventTypeService interface {
+	GetEventTypes(ctx context.Context) ([]models.EventTypeDefinition, error)
+	GetEventType(ctx context.Context, code models.EventType) (models.EventTypeDefinition, error)
+	CreateEventType(ctx context.Context, req models.CreateEventTypeRequest) (models.EventTypeDefinition, error)
+	UpdateEventType(ctx context.Context, code models.EventType, req models.UpdateEventTypeRequest) (models.EventTypeDefinition, error)
+	DeleteEventType(ctx context.Context, code models.EventType) error
+	ValidateEventType(ctx context.Context, code models.EventType, requireActive bool) (models.EventTypeDefinition, error)
+	ValidateAttributes(ctx context.Context, code models.EventType, attributes map[string]interface{}) error
+}
+
+// eventTypeService implementa EventTypeService. Los tipos se leen de una caché en memoria que se
+// invalida al modificar el registro y caduca tras EventTypeCacheTTL, para recoger los cambios
+// hechos desde otras instancias de la aplicación.
+type eventTypeService struct {
+	repository                 repositories.EventTypeRepository
+	eventRepository            repositories.EventRepository
+	ruleRepository             repositories.RuleRepository
+	teamRepository             repositories.TeamRepository
+	slaPolicyRepository        repositories.SLAPolicyRepository
+	escalationPolicyRepository repositories.EscalationPolicyRepository
+	ttl                        time.Duration
+
+	mu        sync.RWMutex
+	registry  *eventTypeRegistry
+	expiresAt time.Time
+	// generation se incrementa en cada invalidación; una carga iniciada antes de una
+	// invalidación no guarda su resultado en la caché
+	generation uint64
+}
+
+// eventTypeRegistry es una instantánea del registro de tipos con los esquemas ya compilados
+type eventTypeRegistry struct {
+	eventTypes []models.EventTypeDefinition
+	byCode     map[models.EventType]models.EventTypeDefinition
+	schemas    map[models.EventType]*jsonschema.Schema
+}
+
+// NewEventTypeService crea una nueva instancia de EventTypeService
+func NewEventTypeService(
+	repository repositories.EventTypeRepository,
+	eventRepository repositories.EventRepository,
+	ruleRepository repositories.RuleRepository,
+	teamRepository repositories.TeamRepository,
+	slaPolicyRepository repositories.SLAPolicyRepository,
+	escalationPolicyRepository repositories.EscalationPolicyRepository,
+	cfg *config.Config,
+) EventTypeService {
+	return &eventTypeService{
+		repository:                 repository,
+		eventRepository:            eventRepository,
+		ruleRepository:             ruleRepository,
+		teamRepository:             teamRepository,
+		slaPolicyRepository:        slaPolicyRepository,
+		escalationPolicyRepository: escalationPolicyRepository,
+		ttl:                        cfg.EventTypeCacheTTL,
+	}
+}
+
+// GetEventTypes recupera todos los tipos de evento registrados, ordenados por código
+func (s *eventTypeService) GetEventTypes(ctx context.Context) ([]models.EventTypeDefinition, error) {
+	registry, err := s.load(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return append([]models.EventTypeDefinition{}, registry.eventTypes...), nil
+}
+
+// GetEventType recupera un tipo de evento por su código
+func (s *eventTypeService) GetEventType(ctx context.Context, code models.EventType) (models.EventTypeDefinition, error) {
+	registry, err := s.load(ctx)
+	if err != nil {
+		return models.EventTypeDefinition{}, err
+	}
+
+	eventType, ok := registry.byCode[normalizeEventTypeCode(code)]
+	if !ok {
+		return models.EventTypeDefinition{}, apierror.NewError(apierror.NotFound, "tipo de evento no encontrado")
+	}
+
+	return eventType, nil
+}
+
+// CreateEventType registra un nuevo tipo de evento
+func (s *eventTypeService) CreateEventType(ctx context.Context, req models.CreateEventTypeRequest) (models.EventTypeDefinition, error) {
+	eventType := models.EventTypeDefinition{
+		Code:                    normalizeEventTypeCode(req.Code),
+		Name:                    strings.TrimSpace(req.Name),
+		Description:             strings.TrimSpace(req.Description),
+		Color:                   strings.ToUpper(strings.TrimSpace(req.Color)),
+		DefaultManagementStatus: req.DefaultManagementStatus,
+		DefaultPriority:         req.DefaultPriority,
+		DefaultSeverity:         3,
+		Active:                  true,
+	}
+
+	if eventType.DefaultPriority == "" {
+		eventType.DefaultPriority = models.PriorityP3
+	}
+
+	if req.DefaultSeverity != nil {
+		eventType.DefaultSeverity = *req.DefaultSeverity
+	}
+
+	if req.Active != nil {
+		eventType.Active = *req.Active
+	}
+
+	if !isNullSchema(req.AttributesSchema) {
+		eventType.AttributesSchema = req.AttributesSchema
+	}
+
+	if !models.EventTypeCodePattern.MatchString(string(eventType.Code)) {
+		return models.EventTypeDefinition{}, apierror.NewError(apierror.ValidationFail, "código de tipo de evento no válido: debe empezar por una letra y contener solo letras, números y guiones bajos (máximo 32 caracteres)")
+	}
+
+	if err := validateEventTypeDefinition(eventType); err != nil {
+		return models.EventTypeDefinition{}, err
+	}
+
+	created, err := s.repository.Create(ctx, eventType)
+	if err != nil {
+		return models.EventTypeDefinition{}, err
+	}
+
+	s.invalidate()
+	return created, nil
+}
+
+// UpdateEventType actualiza los campos proporcionados de un tipo de evento
+func (s *eventTypeService) UpdateEventType(ctx context.Context, code models.EventType, req models.UpdateEventTypeRequest) (models.EventTypeDefinition, error) {
+	eventType, err := s.repository.FindByCode(ctx, normalizeEventTypeCode(code))
+	if err != nil {
+		return models.EventTypeDefinition{}, err
+	}
+
+	// Actualizar solo los campos proporcionados
+	if req.Name != nil {
+		eventType.Name = strings.TrimSpace(*req.Name)
+	}
+
+	if req.Description != nil {
+		eventType.Description = strings.TrimSpace(*req.Description)
+	}
+
+	if req.Color != nil {
+		eventType.Color = strings.ToUpper(strings.TrimSpace(*req.Color))
+	}
+
+	if req.DefaultManagementStatus != nil {
+		eventType.DefaultManagementStatus = *req.DefaultManagementStatus
+	}
+
+	if req.DefaultPriority != nil {
+		eventType.DefaultPriority = *req.DefaultPriority
+	}
+
+	if req.DefaultSeverity != nil {
+		eventType.DefaultSeverity = *req.DefaultSeverity
+	}
+
+	if req.Active != nil {
+		eventType.Active = *req.Active
+	}
+
+	// Los eventos existentes no se vuelven a validar al cambiar el esquema; se validan en su
+	// siguiente actualización
+	if req.AttributesSchema != nil {
+		eventType.AttributesSchema = nil
+		if !isNullSchema(req.AttributesSchema) {
+			eventType.AttributesSchema = req.AttributesSchema
+		}
+	}
+
+	if err := validateEventTypeDefinition(eventType); err != nil {
+		return models.EventTypeDefinition{}, err
+	}
+
+	updated, err := s.repository.Update(ctx, eventType)
+	if err != nil {
+		return models.EventTypeDefinition{}, err
+	}
+
+	s.invalidate()
+	return updated, nil
+}
+
+// DeleteEventType elimina un tipo de evento que no usa ningún evento, regla, equipo ni política.
+// Los tipos en uso se retiran desactivándolos.
+func (s *eventTypeService) DeleteEventType(ctx context.Context, code models.EventType) error {
+	code = normalizeEventTypeCode(code)
+
+	eventType, err := s.repository.FindByCode(ctx, code)
+	if err != nil {
+		return err
+	}
+
+	// El tipo se desactiva antes de comprobar sus referencias para que la validación rechace los
+	// eventos nuevos mientras se comprueban; si está en uso, se restaura su estado
+	if eventType.Active {
+		inactive := eventType
+		inactive.Active = false
+		if _, err := s.repository.Update(ctx, inactive); err != nil {
+			return err
+		}
+		s.invalidate()
+	}
+
+	usedBy, err := s.typeReferences(ctx, code)
+	if err == nil && len(usedBy) > 0 {
+		err = apierror.NewError(apierror.ResourceExists, "el tipo de evento "+string(code)+" está en uso en "+strings.Join(usedBy, ", ")+"; desactívelo en lugar de eliminarlo")
+	}
+	if err == nil {
+		err = s.repository.Delete(ctx, code)
+	}
+	if err == nil {
+		s.invalidate()
+		return nil
+	}
+
+	// La eliminación no se ha completado: el tipo recupera su estado
+	if eventType.Active {
+		if _, restoreErr := s.repository.Update(ctx, eventType); restoreErr != nil {
+			log.Printf("Error al reactivar el tipo de evento %s tras rechazar su eliminación: %v\n", code, restoreErr)
+		}
+		s.invalidate()
+	}
+
+	return err
+}
+
+// typeReferences devuelve los nombres de los recursos que usan un tipo de evento
+func (s *eventTypeService) typeReferences(ctx context.Context, code models.EventType) ([]string, error) {
+	references := []struct {
+		name   string
+		exists func(ctx context.Context, eventType models.EventType) (bool, error)
+	}{
+		{"eventos", s.eventRepository.ExistsByType},
+		{"reglas de gestión", s.ruleRepository.ExistsByType},
+		{"equipos", s.teamRepository.ExistsByType},
+		{"políticas de SLA", s.slaPolicyRepository.ExistsByType},
+		{"políticas de escalado", s.escalationPolicyRepository.ExistsByType},
+	}
+
+	var usedBy []string
+	for _, reference := range references {
+		exists, err := reference.exists(ctx, code)
+		if err != nil {
+			return nil, err
+		}
+		if exists {
+			usedBy = append(usedBy, reference.name)
+		}
+	}
+
+	return usedBy, nil
+}
+
+// ValidateEventType verifica que un tipo de evento esté registrado y, si se indica, que esté
+// activo, y devuelve su definición
+func (s *eventTypeService) ValidateEventType(ctx context.Context, code models.EventType, requireActive bool) (models.EventTypeDefinition, error) {
+	registry, err := s.load(ctx)
+	if err != nil {
+		return models.EventTypeDefinition{}, err
+	}
+
+	eventType, ok := registry.byCode[code]
+	if !ok {
+		return models.EventTypeDefinition{}, apierror.NewError(apierror.ValidationFail, "tipo de evento no válido: "+string(code))
+	}
+
+	if requireActive && !eventType.Active {
+		return models.EventTypeDefinition{}, apierror.NewError(apierror.ValidationFail, "tipo de evento inactivo: "+string(code))
+	}
+
+	return eventType, nil
+}
+
+// ValidateAttributes valida los campos personalizados de un evento con el esquema de su tipo.
+// Los tipos sin esquema admiten cualquier campo.
+func (s *eventTypeService) ValidateAttributes(ctx context.Context, code models.EventType, attributes map[string]interface{}) error {
+	registry, err := s.load(ctx)
+	if err != nil {
+		return err
+	}
+
+	return validateAttributes(code, registry.schemas[code], attributes)
+}
+
+// load devuelve los tipos registrados desde la caché, recargándola del repositorio si ha caducado
+func (s *eventTypeService) load(ctx context.Context) (*eventTypeRegistry, error) {
+	s.mu.RLock()
+	if s.registry != nil && time.Now().Before(s.expiresAt) {
+		defer s.mu.RUnlock()
+		return s.registry, nil
+	}
+	generation := s.generation
+	s.mu.RUnlock()
+
+	eventTypes, err := s.repository.FindAll(ctx)
+	if err != nil {
+		return nil, apierror.NewError(apierror.Internal, "error al cargar los tipos de evento: "+err.Error())
+	}
+
+	registry := &eventTypeRegistry{
+		eventTypes: eventTypes,
+		byCode:     make(map[models.EventType]models.EventTypeDefinition, len(eventTypes)),
+		schemas:    make(map[models.EventType]*jsonschema.Schema),
+	}
+	for _, eventType := range eventTypes {
+		registry.byCode[eventType.Code] = eventType
+
+		if len(eventType.AttributesSchema) == 0 {
+			continue
+		}
+
+		// Los esquemas se validan al guardarlos, por lo que un fallo solo puede deberse a un
+		// cambio hecho directamente en la base de datos
+		schema, err := compileAttributesSchema(eventType.Code, eventType.AttributesSchema)
+		if err != nil {
+			log.Printf("Error al compilar el esquema del tipo de evento %s: %v\n", eventType.Code, err)
+			continue
+		}
+		registry.schemas[eventType.Code] = schema
+	}
+
+	// Si el registro se modificó durante la carga, lo leído puede no incluir el cambio: se
+	// devuelve a quien lo pidió pero no se guarda, y la siguiente lectura vuelve a cargarlo
+	s.mu.Lock()
+	if s.generation == generation {
+		s.registry = registry
+		s.expiresAt = time.Now().Add(s.ttl)
+	}
+	s.mu.Unlock()
+
+	return registry, nil
+}
+
+// invalidate descarta la caché para que la siguiente lectura recargue el registro
+func (s *eventTypeService) invalidate() {
+	s.mu.Lock()
+	s.registry = nil
+	s.generation++
+	s.mu.Unlock()
+}
+
+// validateEventTypeDefinition valida el contenido de un tipo de evento
+func validateEventTypeDefinition(eventType models.EventTypeDefinition) error {
+	if eventType.Name == "" {
+		return apierror.NewError(apierror.ValidationFail, "el nombre del tipo de evento es obligatorio")
+	}
+
+	if eventType.Color != "" && !models.EventTypeColorPattern.MatchString(eventType.Color) {
+		return apierror.NewError(apierror.ValidationFail, "color no válido: use el formato #RRGGBB")
+	}
+
+	if !isValidManagementStatus(eventType.DefaultManagementStatus) {
+		return apierror.NewError(apierror.ValidationFail, "estado de gestión no válido: "+string(eventType.DefaultManagementStatus))
+	}
+
+	if !isValidPriority(eventType.DefaultPriority) {
+		return apierror.NewError(apierror.ValidationFail, "prioridad no válida: "+string(eventType.DefaultPriority))
+	}
+
+	if eventType.DefaultSeverity < models.MinSeverity || eventType.DefaultSeverity > models.MaxSeverity {
+		return apierror.NewError(apierror.ValidationFail, "la severidad debe estar entre 1 y 5")
+	}
+
+	if len(eventType.AttributesSchema) > 0 {
+		return validateAttributesSchema(eventType.Code, eventType.AttributesSchema)
+	}
+
+	return nil
+}
+
+// isNullSchema indica si no se ha indicado un esquema o si se ha indicado null
+func isNullSchema(schema json.RawMessage) bool {
+	return len(schema) == 0 || bytes.Equal(bytes.TrimSpace(schema), []byte("null"))
+}
+
+// normalizeEventTypeCode elimina los espacios exteriores del código de un tipo de evento y lo pasa a mayúsculas
+func normalizeEventTypeCode(code models.EventType) models.EventType {
+	return models.EventType(strings.ToUpper(strings.TrimSpace(string(code))))
+}
@@ -0,0 +1,484 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	"events-api/internal/apierror"
+	"events-api/internal/config"
+	"events-api/internal/models"
+	"events-api/internal/repositories"
+)
+
+// fakeEventTypeRepository es un registro de tipos en memoria que cuenta las cargas completas
+type fakeEventTypeRepository struct {
+	eventTypes map[models.EventType]models.EventTypeDefinition
+	loads      int
+	// findAllErr, si no es nil, se devuelve en la siguiente carga completa
+	findAllErr error
+	// onFindAll, si no es nil, se ejecuta durante cada carga completa antes de leer los tipos
+	onFindAll func()
+}
+
+func newFakeEventTypeRepository(codes ...models.EventType) *fakeEventTypeRepository {
+	repository := &fakeEventTypeRepository{eventTypes: make(map[models.EventType]models.EventTypeDefinition)}
+	for _, code := range codes {
+		repository.eventTypes[code] = testEventType(code)
+	}
+	return repository
+}
+
+func (r *fakeEventTypeRepository) FindAll(ctx context.Context) ([]models.EventTypeDefinition, error) {
+	r.loads++
+
+	if r.onFindAll != nil {
+		r.onFindAll()
+	}
+
+	if err := r.findAllErr; err != nil {
+		r.findAllErr = nil
+		return nil, err
+	}
+
+	eventTypes := make([]models.EventTypeDefinition, 0, len(r.eventTypes))
+	for _, eventType := range r.eventTypes {
+		eventTypes = append(eventTypes, eventType)
+	}
+	sort.Slice(eventTypes, func(i, j int) bool { return eventTypes[i].Code < eventTypes[j].Code })
+
+	return eventTypes, nil
+}
+
+func (r *fakeEventTypeRepository) FindByCode(ctx context.Context, code models.EventType) (models.EventTypeDefinition, error) {
+	eventType, ok := r.eventTypes[code]
+	if !ok {
+		return models.EventTypeDefinition{}, apierror.NewError(apierror.NotFound, "tipo de evento no encontrado")
+	}
+	return eventType, nil
+}
+
+func (r *fakeEventTypeRepository) Create(ctx context.Context, eventType models.EventTypeDefinition) (models.EventTypeDefinition, error) {
+	if _, ok := r.eventTypes[eventType.Code]; ok {
+		return models.EventTypeDefinition{}, apierror.NewError(apierror.ResourceExists, "ya existe un tipo de evento con el código "+string(eventType.Code))
+	}
+	r.eventTypes[eventType.Code] = eventType
+	return eventType, nil
+}
+
+func (r *fakeEventTypeRepository) Update(ctx context.Context, eventType models.EventTypeDefinition) (models.EventTypeDefinition, error) {
+	if _, ok := r.eventTypes[eventType.Code]; !ok {
+		return models.EventTypeDefinition{}, apierror.NewError(apierror.NotFound, "tipo de evento no encontrado")
+	}
+	r.eventTypes[eventType.Code] = eventType
+	return eventType, nil
+}
+
+func (r *fakeEventTypeRepository) Delete(ctx context.Context, code models.EventType) error {
+	if _, ok := r.eventTypes[code]; !ok {
+		return apierror.NewError(apierror.NotFound, "tipo de evento no encontrado")
+	}
+	delete(r.eventTypes, code)
+	return nil
+}
+
+// fakeTypeReferences indica, para cada tipo de evento, si un repositorio lo usa. Se incrusta en
+// los repositorios falsos para implementar ExistsByType; el resto de operaciones no se usan.
+type fakeTypeReferences struct {
+	used map[models.EventType]bool
+	// onExists, si no es nil, se ejecuta en cada consulta antes de responder
+	onExists func()
+}
+
+func (r *fakeTypeReferences) ExistsByType(ctx context.Context, eventType models.EventType) (bool, error) {
+	if r.onExists != nil {
+		r.onExists()
+	}
+	return r.used[eventType], nil
+}
+
+type fakeEventReferences struct {
+	repositories.EventRepository
+	*fakeTypeReferences
+}
+
+func (r fakeEventReferences) ExistsByType(ctx context.Context, eventType models.EventType) (bool, error) {
+	return r.fakeTypeReferences.ExistsByType(ctx, eventType)
+}
+
+type fakeRuleReferences struct {
+	repositories.RuleRepository
+	*fakeTypeReferences
+}
+
+func (r fakeRuleReferences) ExistsByType(ctx context.Context, eventType models.EventType) (bool, error) {
+	return r.fakeTypeReferences.ExistsByType(ctx, eventType)
+}
+
+type fakeTeamReferences struct {
+	repositories.TeamRepository
+	*fakeTypeReferences
+}
+
+func (r fakeTeamReferences) ExistsByType(ctx context.Context, eventType models.EventType) (bool, error) {
+	return r.fakeTypeReferences.ExistsByType(ctx, eventType)
+}
+
+type fakeSLAPolicyReferences struct {
+	repositories.SLAPolicyRepository
+	*fakeTypeReferences
+}
+
+func (r fakeSLAPolicyReferences) ExistsByType(ctx context.Context, eventType models.EventType) (bool, error) {
+	return r.fakeTypeReferences.ExistsByType(ctx, eventType)
+}
+
+type fakeEscalationPolicyReferences struct {
+	repositories.EscalationPolicyRepository
+	*fakeTypeReferences
+}
+
+func (r fakeEscalationPolicyReferences) ExistsByType(ctx context.Context, eventType models.EventType) (bool, error) {
+	return r.fakeTypeReferences.ExistsByType(ctx, eventType)
+}
+
+// testReferences agrupa las referencias de los repositorios que pueden usar un tipo de evento
+type testReferences struct {
+	events, rules, teams, slaPolicies, escalationPolicies *fakeTypeReferences
+}
+
+func newTestReferences() testReferences {
+	return testReferences{
+		events:             &fakeTypeReferences{},
+		rules:              &fakeTypeReferences{},
+		teams:              &fakeTypeReferences{},
+		slaPolicies:        &fakeTypeReferences{},
+		escalationPolicies: &fakeTypeReferences{},
+	}
+}
+
+// newTestEventTypeService crea un servicio de tipos de evento sobre repositorios falsos
+func newTestEventTypeService(repository *fakeEventTypeRepository, references testReferences, ttl time.Duration) *eventTypeService {
+	return NewEventTypeService(
+		repository,
+		fakeEventReferences{fakeTypeReferences: references.events},
+		fakeRuleReferences{fakeTypeReferences: references.rules},
+		fakeTeamReferences{fakeTypeReferences: references.teams},
+		fakeSLAPolicyReferences{fakeTypeReferences: references.slaPolicies},
+		fakeEscalationPolicyReferences{fakeTypeReferences: references.escalationPolicies},
+		&config.Config{EventTypeCacheTTL: ttl},
+	).(*eventTypeService)
+}
+
+// testEventType devuelve una definición válida y activa de un tipo de evento
+func testEventType(code models.EventType) models.EventTypeDefinition {
+	return models.EventTypeDefinition{
+		Code:                    code,
+		Name:                    string(code),
+		DefaultManagementStatus: models.ManagementNotRequired,
+		DefaultPriority:         models.PriorityP3,
+		DefaultSeverity:         3,
+		Active:                  true,
+	}
+}
+
+func TestEventTypeServiceCache(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name string
+		ttl  time.Duration
+		// run realiza las operaciones sobre el servicio y devuelve los códigos de la última lectura
+		run       func(t *testing.T, s *eventTypeService, repository *fakeEventTypeRepository) []models.EventType
+		wantLoads int
+		wantCodes []models.EventType
+	}{
+		{
+			name: "las lecturas consecutivas usan la caché",
+			ttl:  time.Minute,
+			run: func(t *testing.T, s *eventTypeService, repository *fakeEventTypeRepository) []models.EventType {
+				mustGetEventTypes(t, s)
+				mustValidateEventType(t, s, "INFO")
+				return mustGetEventTypes(t, s)
+			},
+			wantLoads: 1,
+			wantCodes: []models.EventType{"ALERT", "INFO"},
+		},
+		{
+			name: "la caché caduca",
+			ttl:  time.Nanosecond,
+			run: func(t *testing.T, s *eventTypeService, repository *fakeEventTypeRepository) []models.EventType {
+				mustGetEventTypes(t, s)
+				time.Sleep(time.Millisecond)
+				return mustGetEventTypes(t, s)
+			},
+			wantLoads: 2,
+			wantCodes: []models.EventType{"ALERT", "INFO"},
+		},
+		{
+			name: "un cambio hecho desde otra instancia no se ve hasta que caduca",
+			ttl:  time.Minute,
+			run: func(t *testing.T, s *eventTypeService, repository *fakeEventTypeRepository) []models.EventType {
+				mustGetEventTypes(t, s)
+				repository.eventTypes["OUTAGE"] = testEventType("OUTAGE")
+				return mustGetEventTypes(t, s)
+			},
+			wantLoads: 1,
+			wantCodes: []models.EventType{"ALERT", "INFO"},
+		},
+		{
+			name: "crear un tipo invalida la caché",
+			ttl:  time.Minute,
+			run: func(t *testing.T, s *eventTypeService, repository *fakeEventTypeRepository) []models.EventType {
+				mustGetEventTypes(t, s)
+				req := models.CreateEventTypeRequest{Code: "outage", Name: "Caída", DefaultManagementStatus: models.ManagementRequired}
+				if _, err := s.CreateEventType(ctx, req); err != nil {
+					t.Fatalf("CreateEventType devolvió %v", err)
+				}
+				mustValidateEventType(t, s, "OUTAGE")
+				return mustGetEventTypes(t, s)
+			},
+			wantLoads: 2,
+			wantCodes: []models.EventType{"ALERT", "INFO", "OUTAGE"},
+		},
+		{
+			name: "desactivar un tipo invalida la caché",
+			ttl:  time.Minute,
+			run: func(t *testing.T, s *eventTypeService, repository *fakeEventTypeRepository) []models.EventType {
+				mustValidateEventType(t, s, "ALERT")
+				active := false
+				if _, err := s.UpdateEventType(ctx, "alert", models.UpdateEventTypeRequest{Active: &active}); err != nil {
+					t.Fatalf("UpdateEventType devolvió %v", err)
+				}
+				_, err := s.ValidateEventType(ctx, "ALERT", true)
+				assertErrorType(t, err, apierror.ValidationFail)
+				return mustGetEventTypes(t, s)
+			},
+			wantLoads: 2,
+			wantCodes: []models.EventType{"ALERT", "INFO"},
+		},
+		{
+			name: "un error de carga no se guarda en la caché",
+			ttl:  time.Minute,
+			run: func(t *testing.T, s *eventTypeService, repository *fakeEventTypeRepository) []models.EventType {
+				repository.findAllErr = errors.New("sin conexión")
+				_, err := s.GetEventTypes(ctx)
+				assertErrorType(t, err, apierror.Internal)
+				return mustGetEventTypes(t, s)
+			},
+			wantLoads: 2,
+			wantCodes: []models.EventType{"ALERT", "INFO"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repository := newFakeEventTypeRepository("ALERT", "INFO")
+			s := newTestEventTypeService(repository, newTestReferences(), tt.ttl)
+
+			codes := tt.run(t, s, repository)
+
+			if repository.loads != tt.wantLoads {
+				t.Errorf("cargas del registro = %d, se esperaban %d", repository.loads, tt.wantLoads)
+			}
+			if !reflect.DeepEqual(codes, tt.wantCodes) {
+				t.Errorf("tipos = %v, se esperaba %v", codes, tt.wantCodes)
+			}
+		})
+	}
+}
+
+func TestEventTypeServiceGeneration(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name string
+		// invalidateDuringLoad simula un cambio del registro mientras se carga por primera vez
+		invalidateDuringLoad bool
+		wantCached           bool
+		wantLoads            int
+	}{
+		{"una carga sin cambios se guarda", false, true, 1},
+		{"una carga que coincide con una invalidación no se guarda", true, false, 2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repository := newFakeEventTypeRepository("ALERT")
+			s := newTestEventTypeService(repository, newTestReferences(), time.Minute)
+
+			if tt.invalidateDuringLoad {
+				repository.onFindAll = func() {
+					// El cambio llega después de que la carga leyera la generación pero antes de
+					// que lea el registro, como haría una solicitud concurrente
+					repository.onFindAll = nil
+					repository.eventTypes["OUTAGE"] = testEventType("OUTAGE")
+					s.invalidate()
+				}
+			}
+
+			// La primera lectura devuelve lo que se leyó aunque no se guarde
+			if _, err := s.GetEventTypes(ctx); err != nil {
+				t.Fatalf("GetEventTypes devolvió %v", err)
+			}
+
+			s.mu.RLock()
+			cached := s.registry != nil
+			s.mu.RUnlock()
+			if cached != tt.wantCached {
+				t.Fatalf("registro en caché = %v, se esperaba %v", cached, tt.wantCached)
+			}
+
+			// La segunda lectura recarga el registro si la primera no se guardó y la tercera ya
+			// usa la caché
+			mustGetEventTypes(t, s)
+			mustGetEventTypes(t, s)
+
+			if repository.loads != tt.wantLoads {
+				t.Errorf("cargas del registro = %d, se esperaban %d", repository.loads, tt.wantLoads)
+			}
+		})
+	}
+}
+
+func TestDeleteEventType(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name string
+		// active es el estado del tipo ALERT antes de eliminarlo
+		active bool
+		// setup marca las referencias al tipo ALERT
+		setup       func(references testReferences)
+		errType     apierror.Type
+		wantMessage []string
+		wantDeleted bool
+	}{
+		{
+			name:        "un tipo sin referencias se elimina",
+			active:      true,
+			setup:       func(references testReferences) {},
+			wantDeleted: true,
+		},
+		{
+			name:        "un tipo inactivo sin referencias se elimina",
+			setup:       func(references testReferences) {},
+			wantDeleted: true,
+		},
+		{
+			name:   "un tipo usado por eventos",
+			active: true,
+			setup: func(references testReferences) {
+				references.events.used = map[models.EventType]bool{"ALERT": true}
+			},
+			errType:     apierror.ResourceExists,
+			wantMessage: []string{"eventos"},
+		},
+		{
+			name:   "un tipo usado por reglas, equipos y políticas",
+			active: true,
+			setup: func(references testReferences) {
+				used := map[models.EventType]bool{"ALERT": true}
+				references.rules.used = used
+				references.teams.used = used
+				references.slaPolicies.used = used
+				references.escalationPolicies.used = used
+			},
+			errType:     apierror.ResourceExists,
+			wantMessage: []string{"reglas de gestión", "equipos", "políticas de SLA", "políticas de escalado"},
+		},
+		{
+			name: "un tipo inactivo en uso sigue inactivo",
+			setup: func(references testReferences) {
+				references.events.used = map[models.EventType]bool{"ALERT": true}
+			},
+			errType:     apierror.ResourceExists,
+			wantMessage: []string{"eventos"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repository := newFakeEventTypeRepository("ALERT", "INFO")
+			alert := repository.eventTypes["ALERT"]
+			alert.Active = tt.active
+			repository.eventTypes["ALERT"] = alert
+
+			references := newTestReferences()
+			tt.setup(references)
+			s := newTestEventTypeService(repository, references, time.Minute)
+
+			// Mientras se comprueban las referencias, el tipo no admite eventos nuevos
+			references.events.onExists = func() {
+				_, err := s.ValidateEventType(ctx, "ALERT", true)
+				assertErrorType(t, err, apierror.ValidationFail)
+			}
+
+			// Se carga la caché para comprobar que la eliminación la invalida
+			if _, err := s.ValidateEventType(ctx, "ALERT", false); err != nil {
+				t.Fatalf("ValidateEventType devolvió %v", err)
+			}
+
+			err := s.DeleteEventType(ctx, " alert ")
+
+			if tt.errType != "" {
+				assertErrorType(t, err, tt.errType)
+				apiErr, _ := apierror.AsError(err)
+				for _, want := range tt.wantMessage {
+					if !strings.Contains(apiErr.Message, want) {
+						t.Errorf("el mensaje %q no menciona %q", apiErr.Message, want)
+					}
+				}
+			} else if err != nil {
+				t.Fatalf("DeleteEventType devolvió %v", err)
+			}
+
+			stored, ok := repository.eventTypes["ALERT"]
+			if ok == tt.wantDeleted {
+				t.Fatalf("tipo registrado = %v tras la eliminación", ok)
+			}
+
+			eventType, err := s.ValidateEventType(ctx, "ALERT", false)
+			if tt.wantDeleted {
+				assertErrorType(t, err, apierror.ValidationFail)
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("ValidateEventType devolvió %v tras rechazar la eliminación", err)
+			}
+			if stored.Active != tt.active || eventType.Active != tt.active {
+				t.Errorf("active = %v tras rechazar la eliminación, se esperaba %v", eventType.Active, tt.active)
+			}
+		})
+	}
+}
+
+// mustGetEventTypes devuelve los códigos de los tipos registrados o falla la prueba
+func mustGetEventTypes(t *testing.T, s *eventTypeService) []models.EventType {
+	t.Helper()
+
+	eventTypes, err := s.GetEventTypes(context.Background())
+	if err != nil {
+		t.Fatalf("GetEventTypes devolvió %v", err)
+	}
+
+	codes := make([]models.EventType, len(eventTypes))
+	for i, eventType := range eventTypes {
+		codes[i] = eventType.Code
+	}
+	return codes
+}
+
+// mustValidateEventType verifica que un tipo esté registrado y activo o falla la prueba
+func mustValidateEventType(t *testing.T, s *eventTypeService, code models.EventType) {
+	t.Helper()
+
+	if _, err := s.ValidateEventType(context.Background(), code, true); err != nil {
+		t.Fatalf("ValidateEventType(%s) devolvió %v", code, err)
+	}
+}
@@ -0,0 +1,261 @@
+package services
+
+import (
+	"context"
+	"math"
+	"strings"
+	"time"
+
+	"events-api/internal/apierror"
+	"events-api/internal/models"
+	"events-api/internal/repositories"
+)
+
+// RuleService define las operaciones del servicio de reglas de gestión
+type RuleService interface {
+	GetAllRules(ctx context.Context) ([]models.ManagementRule, error)
+	GetRuleByID(ctx context.Context, id string) (models.ManagementRule, error)
+	CreateRule(ctx context.Context, req models.CreateRuleRequest) (models.ManagementRule, error)
+	UpdateRule(ctx context.Context, id string, req models.UpdateRuleRequest) (models.ManagementRule, error)
+	DeleteRule(ctx context.Context, id string) error
+	Evaluate(ctx context.Context, event models.Event) (models.RuleEvaluation, error)
+}
+
+// ruleService implementa RuleService
+type ruleService struct {
+	repository       repositories.RuleRepository
+	eventTypeService EventTypeService
+}
+
+// NewRuleService crea una nueva instancia de RuleService
+func NewRuleService(repository repositories.RuleRepository, eventTypeService EventTypeService) RuleService {
+	return &ruleService{
+		repository:       repository,
+		eventTypeService: eventTypeService,
+	}
+}
+
+// GetAllRules recupera todas las reglas en orden de evaluación
+func (s *ruleService) GetAllRules(ctx context.Context) ([]models.ManagementRule, error) {
+	return s.repository.FindAll(ctx)
+}
+
+// GetRuleByID recupera una regla por su ID
+func (s *ruleService) GetRuleByID(ctx context.Context, id string) (models.ManagementRule, error) {
+	return s.repository.FindByID(ctx, id)
+}
+
+// CreateRule crea una nueva regla de gestión
+func (s *ruleService) CreateRule(ctx context.Context, req models.CreateRuleRequest) (models.ManagementRule, error) {
+	rule := models.ManagementRule{
+		Name:        strings.TrimSpace(req.Name),
+		Description: req.Description,
+		Priority:    req.Priority,
+		Enabled:     true,
+		IsDefault:   req.IsDefault,
+		Conditions:  normalizeRuleConditions(req.Conditions),
+		Result:      req.Result,
+	}
+
+	if req.Enabled != nil {
+		rule.Enabled = *req.Enabled
+	}
+
+	if err := s.validateRule(ctx, "", rule); err != nil {
+		return models.ManagementRule{}, err
+	}
+
+	return s.repository.Create(ctx, rule)
+}
+
+// UpdateRule actualiza una regla de gestión existente
+func (s *ruleService) UpdateRule(ctx context.Context, id string, req models.UpdateRuleRequest) (models.ManagementRule, error) {
+	rule, err := s.repository.FindByID(ctx, id)
+	if err != nil {
+		return models.ManagementRule{}, err
+	}
+
+	// Actualizar solo los campos proporcionados
+	if req.Name != nil {
+		rule.Name = strings.TrimSpace(*req.Name)
+	}
+
+	if req.Description != nil {
+		rule.Description = *req.Description
+	}
+
+	if req.Priority != nil {
+		rule.Priority = *req.Priority
+	}
+
+	if req.Enabled != nil {
+		rule.Enabled = *req.Enabled
+	}
+
+	if req.IsDefault != nil {
+		rule.IsDefault = *req.IsDefault
+	}
+
+	if req.Conditions != nil {
+		rule.Conditions = normalizeRuleConditions(*req.Conditions)
+	}
+
+	if req.Result != nil {
+		rule.Result = *req.Result
+	}
+
+	if err := s.validateRule(ctx, id, rule); err != nil {
+		return models.ManagementRule{}, err
+	}
+
+	return s.repository.Update(ctx, id, rule)
+}
+
+// DeleteRule elimina una regla de gestión
+func (s *ruleService) DeleteRule(ctx context.Context, id string) error {
+	return s.repository.Delete(ctx, id)
+}
+
+// Evaluate determina el estado de gestión de un evento. Las reglas habilitadas se evalúan
+// por prioridad y se aplica la primera que coincide; si ninguna coincide se aplica la regla
+// predeterminada y, en su ausencia, la clasificación integrada por tipo de evento.
+func (s *ruleService) Evaluate(ctx context.Context, event models.Event) (models.RuleEvaluation, error) {
+	rules, err := s.repository.FindEnabled(ctx)
+	if err != nil {
+		return models.RuleEvaluation{}, apierror.NewError(apierror.Internal, "error al cargar las reglas de gestión: "+err.Error())
+	}
+
+	now := time.Now()
+	var defaultRule *models.ManagementRule
+	for i := range rules {
+		rule := rules[i]
+		if rule.IsDefault {
+			defaultRule = &rules[i]
+			continue
+		}
+
+		if matchesRule(rule.Conditions, event, now) {
+			return newRuleEvaluation(rule), nil
+		}
+	}
+
+	if defaultRule != nil {
+		return newRuleEvaluation(*defaultRule), nil
+	}
+
+	// Sin reglas aplicables se usa la clasificación predeterminada del tipo de evento
+	eventType, err := s.eventTypeService.ValidateEventType(ctx, event.Type, false)
+	if err != nil {
+		return models.RuleEvaluation{}, err
+	}
+
+	return models.RuleEvaluation{
+		ManagementStatus: eventType.DefaultManagementStatus,
+		RuleName:         models.BuiltinRuleName,
+	}, nil
+}
+
+// validateRule valida el contenido de una regla y que no exista otra regla predeterminada
+func (s *ruleService) validateRule(ctx context.Context, id string, rule models.ManagementRule) error {
+	if rule.Name == "" {
+		return apierror.NewError(apierror.ValidationFail, "el nombre de la regla es obligatorio")
+	}
+
+	if !isValidManagementStatus(rule.Result) {
+		return apierror.NewError(apierror.ValidationFail, "estado de gestión no válido: "+string(rule.Result))
+	}
+
+	for _, eventType := range rule.Conditions.Types {
+		if _, err := s.eventTypeService.ValidateEventType(ctx, eventType, false); err != nil {
+			return err
+		}
+	}
+
+	if rule.Conditions.DateWithinHours != nil && *rule.Conditions.DateWithinHours < 0 {
+		return apierror.NewError(apierror.ValidationFail, "dateWithinHours no puede ser negativo")
+	}
+
+	if rule.IsDefault {
+		existing, err := s.repository.FindDefault(ctx)
+		if err == nil && existing.ID.Hex() != id {
+			return apierror.NewError(apierror.ResourceExists, "ya existe una regla predeterminada: "+existing.Name)
+		}
+		if apiErr, ok := apierror.AsError(err); ok && apiErr.Type != apierror.NotFound {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// normalizeRuleConditions elimina espacios y palabras vacías de las condiciones
+func normalizeRuleConditions(conditions models.RuleConditions) models.RuleConditions {
+	conditions.Keywords = normalizeKeywords(conditions.Keywords)
+	conditions.ExcludeKeywords = normalizeKeywords(conditions.ExcludeKeywords)
+	return conditions
+}
+
+// normalizeKeywords elimina espacios y palabras vacías de una lista de palabras clave
+func normalizeKeywords(keywords []string) []string {
+	var normalized []string
+	for _, keyword := range keywords {
+		if keyword = strings.TrimSpace(keyword); keyword != "" {
+			normalized = append(normalized, keyword)
+		}
+	}
+	return normalized
+}
+
+// matchesRule indica si un evento cumple todas las condiciones de una regla
+func matchesRule(conditions models.RuleConditions, event models.Event, now time.Time) bool {
+	if len(conditions.Types) > 0 {
+		matched := false
+		for _, eventType := range conditions.Types {
+			if eventType == event.Type {
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			return false
+		}
+	}
+
+	text := strings.ToLower(event.Name + " " + event.Description)
+
+	if len(conditions.Keywords) > 0 && !containsAnyKeyword(text, conditions.Keywords) {
+		return false
+	}
+
+	if containsAnyKeyword(text, conditions.ExcludeKeywords) {
+		return false
+	}
+
+	if conditions.DateWithinHours != nil {
+		hours := math.Abs(event.Date.Sub(now).Hours())
+		if hours > float64(*conditions.DateWithinHours) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// containsAnyKeyword indica si el texto contiene alguna de las palabras clave, sin distinguir mayúsculas
+func containsAnyKeyword(text string, keywords []string) bool {
+	for _, keyword := range keywords {
+		if strings.Contains(text, strings.ToLower(keyword)) {
+			return true
+		}
+	}
+	return false
+}
+
+// newRuleEvaluation construye el resultado de evaluación de una regla
+func newRuleEvaluation(rule models.ManagementRule) models.RuleEvaluation {
+	return models.RuleEvaluation{
+		ManagementStatus: rule.Result,
+		RuleID:           rule.ID.Hex(),
+		RuleName:         rule.Name,
+	}
+}
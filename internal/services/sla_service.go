@@ -0,0 +1,111 @@
+package services
+
+import (
+	"context"
+
+	"events-api/internal/apierror"
+	"events-api/internal/models"
+	"events-api/internal/repositories"
+)
+
+// SLAService define las operaciones del servicio de políticas de SLA
+type SLAService interface {
+	GetPolicies(ctx context.Context) ([]models.SLAPolicy, error)
+	GetPolicy(ctx context.Context, eventType models.EventType) (models.SLAPolicy, error)
+	UpdatePolicy(ctx context.Context, eventType models.EventType, req models.UpdateSLAPolicyRequest) (models.SLAPolicy, error)
+	DeletePolicy(ctx context.Context, eventType models.EventType) error
+}
+
+// slaService implementa SLAService
+type slaService struct {
+	repository       repositories.SLAPolicyRepository
+	eventTypeService EventTypeService
+}
+
+// NewSLAService crea una nueva instancia de SLAService
+func NewSLAService(repository repositories.SLAPolicyRepository, eventTypeService EventTypeService) SLAService {
+	return &slaService{
+		repository:       repository,
+		eventTypeService: eventTypeService,
+	}
+}
+
+// GetPolicies devuelve la política vigente de cada tipo de evento: la configurada o, en su
+// ausencia, la predeterminada
+func (s *slaService) GetPolicies(ctx context.Context) ([]models.SLAPolicy, error) {
+	configured, err := s.repository.FindAll(ctx)
+	if err != nil {
+		return nil, apierror.NewError(apierror.Internal, "error al cargar las políticas de SLA: "+err.Error())
+	}
+
+	byType := make(map[models.EventType]models.SLAPolicy, len(configured))
+	for _, policy := range configured {
+		byType[policy.Type] = policy
+	}
+
+	eventTypes, err := s.eventTypeService.GetEventTypes(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	policies := make([]models.SLAPolicy, 0, len(eventTypes))
+	for _, eventType := range eventTypes {
+		policy, ok := byType[eventType.Code]
+		if !ok {
+			policy = defaultSLAPolicy(eventType.Code)
+		}
+		policies = append(policies, policy)
+	}
+
+	return policies, nil
+}
+
+// GetPolicy devuelve la política vigente de un tipo de evento
+func (s *slaService) GetPolicy(ctx context.Context, eventType models.EventType) (models.SLAPolicy, error) {
+	if _, err := s.eventTypeService.ValidateEventType(ctx, eventType, false); err != nil {
+		return models.SLAPolicy{}, err
+	}
+
+	policy, err := s.repository.FindByType(ctx, eventType)
+	if apiErr, ok := apierror.AsError(err); ok && apiErr.Type == apierror.NotFound {
+		return defaultSLAPolicy(eventType), nil
+	}
+
+	return policy, err
+}
+
+// UpdatePolicy configura los plazos de un tipo de evento. Los nuevos plazos se aplican a los
+// eventos que se creen o revisen a partir de ese momento.
+func (s *slaService) UpdatePolicy(ctx context.Context, eventType models.EventType, req models.UpdateSLAPolicyRequest) (models.SLAPolicy, error) {
+	if _, err := s.eventTypeService.ValidateEventType(ctx, eventType, false); err != nil {
+		return models.SLAPolicy{}, err
+	}
+
+	if *req.TimeToReviewMinutes < 0 || *req.TimeToResolveMinutes < 0 {
+		return models.SLAPolicy{}, apierror.NewError(apierror.ValidationFail, "los plazos no pueden ser negativos")
+	}
+
+	return s.repository.Upsert(ctx, models.SLAPolicy{
+		Type:                 eventType,
+		TimeToReviewMinutes:  *req.TimeToReviewMinutes,
+		TimeToResolveMinutes: *req.TimeToResolveMinutes,
+	})
+}
+
+// DeletePolicy elimina la política configurada de un tipo de evento, que vuelve a usar los
+// plazos predeterminados
+func (s *slaService) DeletePolicy(ctx context.Context, eventType models.EventType) error {
+	if _, err := s.eventTypeService.ValidateEventType(ctx, eventType, false); err != nil {
+		return err
+	}
+
+	return s.repository.Delete(ctx, eventType)
+}
+
+// defaultSLAPolicy devuelve la política predeterminada de un tipo de evento
+func defaultSLAPolicy(eventType models.EventType) models.SLAPolicy {
+	policy := models.DefaultSLAPolicies[eventType]
+	policy.Type = eventType
+	policy.IsDefault = true
+	return policy
+}
@@ -0,0 +1,281 @@
+package services
+
+import (
+	"context"
+	"log"
+	"strings"
+
+	"events-api/internal/apierror"
+	"events-api/internal/models"
+	"events-api/internal/repositories"
+)
+
+// TeamService define las operaciones del servicio de equipos
+type TeamService interface {
+	GetAllTeams(ctx context.Context) ([]models.Team, error)
+	GetTeamByID(ctx context.Context, id string) (models.Team, error)
+	CreateTeam(ctx context.Context, req models.CreateTeamRequest) (models.Team, error)
+	UpdateTeam(ctx context.Context, id string, req models.UpdateTeamRequest) (models.Team, error)
+	DeleteTeam(ctx context.Context, id string) error
+	ResolveAssignment(ctx context.Context, req models.AssignEventRequest) (models.Assignment, error)
+	AutoAssign(ctx context.Context, event models.Event) (models.Assignment, bool)
+	PlanAutoAssign(ctx context.Context, event models.Event, rotation *models.AssignmentRotation) (models.Assignment, bool)
+	AdvanceRotation(ctx context.Context, used map[string]int64)
+}
+
+// teamService implementa TeamService
+type teamService struct {
+	repository       repositories.TeamRepository
+	eventRepository  repositories.EventRepository
+	eventTypeService EventTypeService
+}
+
+// NewTeamService crea una nueva instancia de TeamService
+func NewTeamService(repository repositories.TeamRepository, eventRepository repositories.EventRepository, eventTypeService EventTypeService) TeamService {
+	return &teamService{
+		repository:       repository,
+		eventRepository:  eventRepository,
+		eventTypeService: eventTypeService,
+	}
+}
+
+// GetAllTeams recupera todos los equipos
+func (s *teamService) GetAllTeams(ctx context.Context) ([]models.Team, error) {
+	return s.repository.FindAll(ctx)
+}
+
+// GetTeamByID recupera un equipo por su ID
+func (s *teamService) GetTeamByID(ctx context.Context, id string) (models.Team, error) {
+	return s.repository.FindByID(ctx, id)
+}
+
+// CreateTeam crea un nuevo equipo
+func (s *teamService) CreateTeam(ctx context.Context, req models.CreateTeamRequest) (models.Team, error) {
+	team := models.Team{
+		Name:       strings.TrimSpace(req.Name),
+		Members:    normalizeMembers(req.Members),
+		EventTypes: req.EventTypes,
+		AutoAssign: true,
+	}
+
+	if req.AutoAssign != nil {
+		team.AutoAssign = *req.AutoAssign
+	}
+
+	if err := s.validateTeam(ctx, team); err != nil {
+		return models.Team{}, err
+	}
+
+	return s.repository.Create(ctx, team)
+}
+
+// UpdateTeam actualiza un equipo existente
+func (s *teamService) UpdateTeam(ctx context.Context, id string, req models.UpdateTeamRequest) (models.Team, error) {
+	team, err := s.repository.FindByID(ctx, id)
+	if err != nil {
+		return models.Team{}, err
+	}
+
+	// Actualizar solo los campos proporcionados
+	if req.Name != nil {
+		team.Name = strings.TrimSpace(*req.Name)
+	}
+
+	if req.Members != nil {
+		team.Members = normalizeMembers(*req.Members)
+	}
+
+	if req.EventTypes != nil {
+		team.EventTypes = *req.EventTypes
+	}
+
+	if req.AutoAssign != nil {
+		team.AutoAssign = *req.AutoAssign
+	}
+
+	if err := s.validateTeam(ctx, team); err != nil {
+		return models.Team{}, err
+	}
+
+	return s.repository.Update(ctx, id, team)
+}
+
+// DeleteTeam elimina un equipo. Los eventos asignados conservan su responsable pero dejan de
+// estar asignados al equipo.
+func (s *teamService) DeleteTeam(ctx context.Context, id string) error {
+	if err := s.repository.Delete(ctx, id); err != nil {
+		return err
+	}
+
+	return s.eventRepository.ClearTeam(ctx, id)
+}
+
+// ResolveAssignment valida una solicitud de asignación. Si solo se indica el equipo, el evento
+// se asigna por turnos a uno de sus miembros; si se indican ambos, el usuario debe pertenecer
+// al equipo. Un equipo nulo o vacío asigna el evento solo al usuario.
+func (s *teamService) ResolveAssignment(ctx context.Context, req models.AssignEventRequest) (models.Assignment, error) {
+	assignment := models.Assignment{
+		AssigneeID: strings.TrimSpace(req.AssigneeID),
+	}
+	if req.TeamID != nil {
+		assignment.TeamID = strings.TrimSpace(*req.TeamID)
+	}
+
+	if assignment.TeamID == "" {
+		if assignment.AssigneeID == "" {
+			return models.Assignment{}, apierror.NewError(apierror.ValidationFail, "indique el usuario o el equipo al que se asigna el evento")
+		}
+		return assignment, nil
+	}
+
+	if assignment.AssigneeID == "" {
+		member, err := s.repository.NextMember(ctx, assignment.TeamID)
+		if err != nil {
+			return models.Assignment{}, err
+		}
+		assignment.AssigneeID = member
+		return assignment, nil
+	}
+
+	team, err := s.repository.FindByID(ctx, assignment.TeamID)
+	if err != nil {
+		return models.Assignment{}, err
+	}
+
+	for _, member := range team.Members {
+		if member == assignment.AssigneeID {
+			return assignment, nil
+		}
+	}
+
+	return models.Assignment{}, apierror.NewError(apierror.ValidationFail, "el usuario "+assignment.AssigneeID+" no pertenece al equipo "+team.Name)
+}
+
+// AutoAssign elige por turnos el responsable de un evento que pasa a requerir gestión. Se usa el
+// primer equipo con asignación automática que atiende el tipo del evento y, si no hay ninguno, el
+// primero que atiende cualquier tipo. Devuelve false si ningún equipo puede atender el evento;
+// un fallo al asignar no impide la operación que lo provoca y solo se deja constancia en el log.
+func (s *teamService) AutoAssign(ctx context.Context, event models.Event) (models.Assignment, bool) {
+	selected, ok := s.selectAutoAssignTeam(ctx, event)
+	if !ok {
+		return models.Assignment{}, false
+	}
+
+	member, err := s.repository.NextMember(ctx, selected.ID.Hex())
+	if err != nil {
+		log.Printf("Error al asignar el evento %s al equipo %s: %v\n", event.ID.Hex(), selected.Name, err)
+		return models.Assignment{}, false
+	}
+
+	return models.Assignment{TeamID: selected.ID.Hex(), AssigneeID: member}, true
+}
+
+// PlanAutoAssign elige el responsable de un evento como AutoAssign, pero sin consumir el turno
+// del equipo: el turno se anota en rotation y se consume con AdvanceRotation cuando la operación
+// masiva que lo usa se aplica
+func (s *teamService) PlanAutoAssign(ctx context.Context, event models.Event, rotation *models.AssignmentRotation) (models.Assignment, bool) {
+	selected, ok := s.selectAutoAssignTeam(ctx, event)
+	if !ok {
+		return models.Assignment{}, false
+	}
+
+	if rotation.Used == nil {
+		rotation.Used = make(map[string]int64)
+	}
+
+	teamID := selected.ID.Hex()
+	turn := selected.AssignmentCount + rotation.Used[teamID]
+	rotation.Used[teamID]++
+
+	return models.Assignment{TeamID: teamID, AssigneeID: selected.Members[turn%int64(len(selected.Members))]}, true
+}
+
+// AdvanceRotation consume en cada equipo los turnos de asignación indicados. Un fallo no revierte
+// la operación que los usó y solo se deja constancia en el log.
+func (s *teamService) AdvanceRotation(ctx context.Context, used map[string]int64) {
+	for teamID, count := range used {
+		if count <= 0 {
+			continue
+		}
+		if err := s.repository.AdvanceRotation(ctx, teamID, count); err != nil {
+			log.Printf("Error al actualizar los turnos de asignación del equipo %s: %v\n", teamID, err)
+		}
+	}
+}
+
+// selectAutoAssignTeam elige el equipo que atiende por turnos un evento: el primero con
+// asignación automática que atiende su tipo o, si no hay ninguno, el primero que atiende
+// cualquier tipo
+func (s *teamService) selectAutoAssignTeam(ctx context.Context, event models.Event) (models.Team, bool) {
+	teams, err := s.repository.FindAutoAssign(ctx)
+	if err != nil {
+		log.Printf("Error al cargar los equipos para asignar el evento %s: %v\n", event.ID.Hex(), err)
+		return models.Team{}, false
+	}
+
+	var selected *models.Team
+	for i := range teams {
+		team := &teams[i]
+		if len(team.Members) == 0 {
+			continue
+		}
+
+		if len(team.EventTypes) == 0 {
+			if selected == nil {
+				selected = team
+			}
+			continue
+		}
+
+		if containsEventType(team.EventTypes, event.Type) {
+			selected = team
+			break
+		}
+	}
+
+	if selected == nil {
+		return models.Team{}, false
+	}
+
+	return *selected, true
+}
+
+// validateTeam valida el contenido de un equipo
+func (s *teamService) validateTeam(ctx context.Context, team models.Team) error {
+	if team.Name == "" {
+		return apierror.NewError(apierror.ValidationFail, "el nombre del equipo es obligatorio")
+	}
+
+	for _, eventType := range team.EventTypes {
+		if _, err := s.eventTypeService.ValidateEventType(ctx, eventType, false); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// normalizeMembers elimina espacios, valores vacíos y miembros repetidos conservando el orden
+func normalizeMembers(members []string) []string {
+	normalized := make([]string, 0, len(members))
+	seen := make(map[string]bool, len(members))
+	for _, member := range members {
+		member = strings.TrimSpace(member)
+		if member == "" || seen[member] {
+			continue
+		}
+		seen[member] = true
+		normalized = append(normalized, member)
+	}
+	return normalized
+}
+
+// containsEventType indica si el tipo de evento está en la lista
+func containsEventType(eventTypes []models.EventType, eventType models.EventType) bool {
+	for _, candidate := range eventTypes {
+		if candidate == eventType {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,41 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"events-api/internal/config"
+
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// Almacenes de archivos disponibles
+const (
+	LocalStorage  = "local"
+	GridFSStorage = "gridfs"
+)
+
+// BlobStore define las operaciones de un almacén de archivos. Los archivos se identifican por
+// una clave que elige quien los guarda.
+type BlobStore interface {
+	// Put guarda el contenido con la clave indicada y devuelve el número de bytes escritos
+	Put(ctx context.Context, key string, content io.Reader) (int64, error)
+	// Open abre el contenido guardado con la clave indicada. El contenido admite Seek para
+	// poder servir rangos de bytes.
+	Open(ctx context.Context, key string) (io.ReadSeekCloser, error)
+	// Delete elimina el contenido guardado con la clave indicada; no falla si no existe
+	Delete(ctx context.Context, key string) error
+}
+
+// NewBlobStore crea el almacén de archivos indicado en la configuración
+func NewBlobStore(client *mongo.Client, cfg *config.Config) (BlobStore, error) {
+	switch cfg.AttachmentStorage {
+	case LocalStorage:
+		return NewLocalBlobStore(cfg.AttachmentDir)
+	case GridFSStorage:
+		return NewGridFSBlobStore(client.Database(cfg.MongoDatabase), cfg.AttachmentBucket)
+	default:
+		return nil, fmt.Errorf("almacén de archivos no válido: %q", cfg.AttachmentStorage)
+	}
+}
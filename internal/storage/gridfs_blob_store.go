@@ -0,0 +1,146 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"io"
+
+	"events-api/internal/apierror"
+
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/gridfs"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// gridFSBlobStore implementa BlobStore sobre un bucket de GridFS. La clave de cada archivo se
+// usa como su _id en el bucket.
+type gridFSBlobStore struct {
+	bucket *gridfs.Bucket
+}
+
+// NewGridFSBlobStore crea un almacén de archivos en el bucket de GridFS indicado
+func NewGridFSBlobStore(db *mongo.Database, bucketName string) (BlobStore, error) {
+	bucket, err := gridfs.NewBucket(db, options.GridFSBucket().SetName(bucketName))
+	if err != nil {
+		return nil, err
+	}
+
+	return &gridFSBlobStore{
+		bucket: bucket,
+	}, nil
+}
+
+// Put guarda el contenido en el bucket. Si la copia falla, los fragmentos ya escritos se descartan.
+func (s *gridFSBlobStore) Put(ctx context.Context, key string, content io.Reader) (int64, error) {
+	stream, err := s.bucket.OpenUploadStreamWithID(key, key)
+	if err != nil {
+		return 0, err
+	}
+
+	written, err := io.Copy(stream, content)
+	if err != nil {
+		_ = stream.Abort()
+		return written, err
+	}
+
+	return written, stream.Close()
+}
+
+// Open abre el archivo guardado con la clave indicada
+func (s *gridFSBlobStore) Open(ctx context.Context, key string) (io.ReadSeekCloser, error) {
+	stream, err := s.bucket.OpenDownloadStream(key)
+	if err != nil {
+		if errors.Is(err, gridfs.ErrFileNotFound) {
+			return nil, apierror.NewError(apierror.NotFound, "contenido del archivo no encontrado")
+		}
+		return nil, err
+	}
+
+	return &gridFSBlob{
+		bucket: s.bucket,
+		key:    key,
+		size:   stream.GetFile().Length,
+		stream: stream,
+	}, nil
+}
+
+// Delete elimina el archivo guardado con la clave indicada
+func (s *gridFSBlobStore) Delete(ctx context.Context, key string) error {
+	if err := s.bucket.DeleteContext(ctx, key); err != nil && !errors.Is(err, gridfs.ErrFileNotFound) {
+		return err
+	}
+
+	return nil
+}
+
+// gridFSBlob adapta una descarga de GridFS, que solo avanza hacia delante, a io.ReadSeekCloser.
+// Al cambiar de posición se descarta la descarga en curso y la siguiente lectura abre otra
+// desde la nueva posición.
+type gridFSBlob struct {
+	bucket *gridfs.Bucket
+	key    string
+	size   int64
+	offset int64
+	stream *gridfs.DownloadStream
+}
+
+// Read lee el contenido desde la posición actual
+func (b *gridFSBlob) Read(p []byte) (int, error) {
+	if b.offset >= b.size {
+		return 0, io.EOF
+	}
+
+	if b.stream == nil {
+		stream, err := b.bucket.OpenDownloadStream(b.key)
+		if err != nil {
+			return 0, err
+		}
+		if _, err := stream.Skip(b.offset); err != nil {
+			_ = stream.Close()
+			return 0, err
+		}
+		b.stream = stream
+	}
+
+	n, err := b.stream.Read(p)
+	b.offset += int64(n)
+	return n, err
+}
+
+// Seek cambia la posición de la siguiente lectura
+func (b *gridFSBlob) Seek(offset int64, whence int) (int64, error) {
+	var position int64
+	switch whence {
+	case io.SeekStart:
+		position = offset
+	case io.SeekCurrent:
+		position = b.offset + offset
+	case io.SeekEnd:
+		position = b.size + offset
+	default:
+		return 0, errors.New("gridfs: whence no válido")
+	}
+
+	if position < 0 {
+		return 0, errors.New("gridfs: posición negativa")
+	}
+
+	if position != b.offset && b.stream != nil {
+		_ = b.stream.Close()
+		b.stream = nil
+	}
+
+	b.offset = position
+	return position, nil
+}
+
+// Close cierra la descarga en curso
+func (b *gridFSBlob) Close() error {
+	if b.stream == nil {
+		return nil
+	}
+
+	err := b.stream.Close()
+	b.stream = nil
+	return err
+}
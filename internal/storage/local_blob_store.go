@@ -0,0 +1,110 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"events-api/internal/apierror"
+)
+
+// localBlobStore implementa BlobStore sobre un directorio del sistema de archivos
+type localBlobStore struct {
+	root string
+}
+
+// NewLocalBlobStore crea un almacén de archivos en el directorio indicado, que se crea si no existe
+func NewLocalBlobStore(root string) (BlobStore, error) {
+	root, err := filepath.Abs(root)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := os.MkdirAll(root, 0o750); err != nil {
+		return nil, err
+	}
+
+	return &localBlobStore{
+		root: root,
+	}, nil
+}
+
+// Put guarda el contenido en un archivo temporal y lo renombra al terminar, de modo que nunca
+// se lee un archivo a medio escribir
+func (s *localBlobStore) Put(ctx context.Context, key string, content io.Reader) (int64, error) {
+	path, err := s.path(key)
+	if err != nil {
+		return 0, err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0o750); err != nil {
+		return 0, err
+	}
+
+	file, err := os.CreateTemp(filepath.Dir(path), ".upload-*")
+	if err != nil {
+		return 0, err
+	}
+	defer os.Remove(file.Name())
+
+	written, err := io.Copy(file, content)
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return written, err
+	}
+
+	return written, os.Rename(file.Name(), path)
+}
+
+// Open abre el archivo guardado con la clave indicada
+func (s *localBlobStore) Open(ctx context.Context, key string) (io.ReadSeekCloser, error) {
+	path, err := s.path(key)
+	if err != nil {
+		return nil, err
+	}
+
+	file, err := os.Open(path)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil, apierror.NewError(apierror.NotFound, "contenido del archivo no encontrado")
+		}
+		return nil, err
+	}
+
+	return file, nil
+}
+
+// Delete elimina el archivo guardado con la clave indicada y su directorio si queda vacío
+func (s *localBlobStore) Delete(ctx context.Context, key string) error {
+	path, err := s.path(key)
+	if err != nil {
+		return err
+	}
+
+	if err := os.Remove(path); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return err
+	}
+
+	// Solo se elimina si está vacío; en otro caso el error se ignora
+	if dir := filepath.Dir(path); dir != s.root {
+		_ = os.Remove(dir)
+	}
+
+	return nil
+}
+
+// path devuelve la ruta del archivo de una clave, que no puede salir del directorio raíz
+func (s *localBlobStore) path(key string) (string, error) {
+	path := filepath.Join(s.root, filepath.FromSlash(key))
+	if key == "" || !strings.HasPrefix(path, s.root+string(filepath.Separator)) {
+		return "", apierror.NewError(apierror.BadRequest, "clave de archivo inválida: "+key)
+	}
+
+	return path, nil
+}
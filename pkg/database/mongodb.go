@@ -34,6 +34,6 @@ func NewMongoClient(cfg *config.Config) (*mongo.Client, error) {
 }
 
 // GetCollection obtiene una colección de MongoDB
-func GetCollection(client *mongo.Client, cfg *config.Config, collectionName string) *mongo.Collection {
-	return client.Database(cfg.MongoDatabase).Collection(collectionName)
+func GetCollection(client *mongo.Client, cfg *config.Config, collectionName string, opts ...*options.CollectionOptions) *mongo.Collection {
+	return client.Database(cfg.MongoDatabase).Collection(collectionName, opts...)
 }
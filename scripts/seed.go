@@ -37,6 +37,13 @@ func main() {
 			Status:      models.StatusPending,
 			CreatedAt:   time.Now(),
 			UpdatedAt:   time.Now(),
+			Attributes: map[string]interface{}{
+				"window": map[string]interface{}{
+					"start": time.Now().AddDate(0, 0, 7).UTC().Format(time.RFC3339),
+					"end":   time.Now().AddDate(0, 0, 7).Add(4 * time.Hour).UTC().Format(time.RFC3339),
+				},
+				"affectedSystems": []interface{}{"facturacion", "base-de-datos"},
+			},
 		},
 		models.Event{
 			ID:          primitive.NewObjectID(),
@@ -47,6 +54,10 @@ func main() {
 			Status:      models.StatusPending,
 			CreatedAt:   time.Now(),
 			UpdatedAt:   time.Now(),
+			Attributes: map[string]interface{}{
+				"source":    "ids",
+				"threshold": 5,
+			},
 		},
 		models.Event{
 			ID:              primitive.NewObjectID(),